@@ -1,6 +1,8 @@
 package main
 
 import (
+	"time"
+
 	"github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 	"github.com/urfave/cli"
@@ -41,6 +43,18 @@ var cliFlags = []cli.Flag{
 		EnvVar: envPrefix("GTW_GRPC_PORT"),
 		Value:  "8443",
 	},
+	cli.DurationFlag{
+		Name:   "dmn-scheduler-interval",
+		Usage:  "Delay between scheduler daemon iterations.",
+		EnvVar: envPrefix("DMN_SCHEDULER_INTERVAL"),
+		Value:  time.Second,
+	},
+	cli.DurationFlag{
+		Name:   "dmn-scheduler-worker-timeout",
+		Usage:  "Max delay between worker heartbeats before the worker is considered dead.",
+		EnvVar: envPrefix("DMN_SCHEDULER_WORKER_TIMEOUT"),
+		Value:  30 * time.Second,
+	},
 	cli.BoolFlag{
 		Name:   "debug, d",
 		Usage:  "Enable debug mode.",
@@ -77,6 +91,12 @@ func NewConfigFromCtx(ctx *cli.Context) (cfg *config, err error) {
 				Port:     ctx.String("gtw-rest-port"),
 			},
 		},
+		Dmn: &configDmn{
+			Scheduler: &configDmnScheduler{
+				Interval:      ctx.Duration("dmn-scheduler-interval"),
+				WorkerTimeout: ctx.Duration("dmn-scheduler-worker-timeout"),
+			},
+		},
 		Misc: &configMisc{
 			DebugMode:     ctx.Bool("debug"),
 			LogFormatText: ctx.Bool("text"),
@@ -95,6 +115,7 @@ func NewConfigFromCtx(ctx *cli.Context) (cfg *config, err error) {
 type config struct {
 	Db   *configDb
 	Gtw  *configGtw
+	Dmn  *configDmn
 	Misc *configMisc
 }
 
@@ -103,6 +124,7 @@ func (c *config) Validate() (err error) {
 	return validation.ValidateStruct(c,
 		validation.Field(&c.Db, validation.Required),
 		validation.Field(&c.Gtw, validation.Required),
+		validation.Field(&c.Dmn, validation.Required),
 		validation.Field(&c.Misc, validation.Required),
 	)
 }
@@ -162,6 +184,32 @@ func (c *configGtwGrpc) Validate() (err error) {
 	)
 }
 
+// configDmn represents daemons configuration.
+type configDmn struct {
+	Scheduler *configDmnScheduler
+}
+
+// Validate is responsible for data validation.
+func (c *configDmn) Validate() (err error) {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Scheduler, validation.Required),
+	)
+}
+
+// configDmnScheduler represents scheduler daemon configuration.
+type configDmnScheduler struct {
+	Interval      time.Duration
+	WorkerTimeout time.Duration
+}
+
+// Validate is responsible for data validation.
+func (c *configDmnScheduler) Validate() (err error) {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Interval, validation.Required, validation.Min(10*time.Millisecond)),
+		validation.Field(&c.WorkerTimeout, validation.Required, validation.Min(time.Second)),
+	)
+}
+
 // configMisc represents other configuration options.
 type configMisc struct {
 	DebugMode     bool
@@ -9,6 +9,7 @@ import (
 	"net"
 
 	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/services/daemons"
 	"github.com/gork-io/gork/services/resources"
 	"github.com/gork-io/gork/transformers/gateways/grpc"
 	"github.com/gork-io/gork/transformers/gateways/grpc/controllers"
@@ -66,19 +67,62 @@ func action(ctx *cli.Context) (err error) {
 
 	// Initialize repositories
 	queuesRepo := redis_repo.NewQueuesRepository(redisClient)
+	tasksRepo := redis_repo.NewTasksRepository(redisClient)
+	jobsRepo := redis_repo.NewJobsRepository(redisClient)
+	schedulesRepo := redis_repo.NewSchedulesRepository(redisClient)
+	workersRepo := redis_repo.NewWorkersRepository(redisClient)
+	exchangesRepo := redis_repo.NewExchangesRepository(redisClient)
+	bindingsRepo := redis_repo.NewExchangeBindingsRepository(redisClient)
+	workflowsRepo := redis_repo.NewWorkflowsRepository(redisClient)
+	batchesRepo := redis_repo.NewBatchesRepository(redisClient)
 
 	// Initialize services
-	queuesSvc := resources.NewQueues(queuesRepo)
+	exchangeRouters := resources.NewExchangeRouters()
+	queuesSvc := resources.NewQueues(queuesRepo, tasksRepo)
+	tasksSvc := resources.NewTasks(tasksRepo, queuesRepo, exchangesRepo, bindingsRepo, exchangeRouters)
+	jobsSvc := resources.NewJobs(jobsRepo, tasksRepo, queuesRepo, workersRepo)
+	schedulesSvc := resources.NewSchedules(schedulesRepo, queuesRepo)
+	workersSvc := resources.NewWorkers(workersRepo, queuesRepo)
+	exchangesSvc := resources.NewExchanges(exchangesRepo, bindingsRepo, exchangeRouters)
+	bindingsSvc := resources.NewExchangeBindings(bindingsRepo, exchangesRepo, queuesRepo)
+	workflowsSvc := resources.NewWorkflows(workflowsRepo, tasksRepo, queuesRepo)
+	batchesSvc := resources.NewBatches(batchesRepo, queuesRepo)
+
+	// Initialize daemons
+	scheduler := daemons.NewScheduler(
+		queuesRepo,
+		tasksRepo,
+		jobsRepo,
+		schedulesRepo,
+		workersRepo,
+		logger,
+		config.Dmn.Scheduler.Interval,
+		config.Dmn.Scheduler.WorkerTimeout,
+	)
 
 	// Initialize gateways
 	listener, err := net.Listen("tcp", net.JoinHostPort(config.Gtw.Grpc.Hostname, config.Gtw.Grpc.Port))
 	if err != nil {
 		return
 	}
-	grpcGateway := grpc.NewGateway(listener, controllers.NewQueues(queuesSvc))
+	grpcGateway := grpc.NewGateway(
+		listener,
+		controllers.NewQueues(queuesSvc),
+		controllers.NewTasks(tasksSvc),
+		controllers.NewJobs(jobsSvc),
+		controllers.NewSchedules(schedulesSvc),
+		controllers.NewWorkers(workersSvc),
+		controllers.NewExchanges(exchangesSvc),
+		controllers.NewExchangeBindings(bindingsSvc),
+		controllers.NewWorkflows(workflowsSvc),
+		controllers.NewBatches(batchesSvc),
+	)
 
 	// Initialize server
-	server, err := NewServer(ServerWithGateways(grpcGateway))
+	server, err := NewServer(
+		ServerWithGateways(grpcGateway),
+		ServerWithDaemons(scheduler),
+	)
 	if err != nil {
 		return
 	}
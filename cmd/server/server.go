@@ -6,6 +6,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/gork-io/gork/services/daemons"
 	"github.com/gork-io/gork/transformers/gateways"
 	"github.com/pkg/errors"
 )
@@ -25,14 +26,20 @@ func NewServer(options ...ServerOption) (server *Server, err error) {
 	return
 }
 
-// Server is a container that holds and manages all application gateways.
+// Server is a container that holds and manages all application gateways and daemons.
 type Server struct {
 	gateways []gateways.Gateway // registered gateways
+	daemons  []daemons.Daemon   // registered daemons
 }
 
-// Start launches registered gateways.
+// Start launches registered daemons and gateways.
 func (srv *Server) Start() (err error) {
 
+	// Start all daemons
+	for _, daemon := range srv.daemons {
+		go daemon.Run()
+	}
+
 	errsChan := make(chan error)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
@@ -56,7 +63,7 @@ func (srv *Server) Start() (err error) {
 	}
 }
 
-// Stop asks registered gateways to stop gracefully.
+// Stop asks registered gateways and daemons to stop gracefully.
 func (srv *Server) Stop() {
 
 	wg := sync.WaitGroup{}
@@ -70,6 +77,16 @@ func (srv *Server) Stop() {
 		}(gateway)
 	}
 	wg.Wait()
+
+	// Stop all daemons
+	wg.Add(len(srv.daemons))
+	for _, daemon := range srv.daemons {
+		go func(daemon daemons.Daemon) {
+			daemon.Stop()
+			wg.Done()
+		}(daemon)
+	}
+	wg.Wait()
 }
 
 // ServerOption is used to set custom server options.
@@ -81,3 +98,10 @@ func ServerWithGateways(gateways ...gateways.Gateway) (option ServerOption) {
 		srv.gateways = append(srv.gateways, gateways...)
 	}
 }
+
+// ServerWithDaemons appends given daemons to the server.
+func ServerWithDaemons(daemons ...daemons.Daemon) (option ServerOption) {
+	return func(srv *Server) {
+		srv.daemons = append(srv.daemons, daemons...)
+	}
+}
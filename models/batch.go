@@ -0,0 +1,66 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+// BatchesRepository is an interface that all batches storage should implement.
+type BatchesRepository interface {
+	// Save persists given batch instance along with its tasks and its callback task to the repo
+	// in a single transaction. Callback task is waiting until all tasks of the batch are settled.
+	Save(ctx context.Context, record *Batch, tasks []*Task, callback *Task) (err error)
+	// GetById retrieves batch with given ID from the repo.
+	GetById(ctx context.Context, id string) (record *Batch, err error)
+	// MGetById retrieves batches with given IDs from the repo.
+	MGetById(ctx context.Context, ids []string) (records []*Batch, err error)
+	// Find returns a subset of the batches, based on collection params given.
+	Find(ctx context.Context, params *CollectionParams) (records []*Batch, info *CollectionInfo, err error)
+}
+
+// NewBatch creates a new instance of Batch.
+func NewBatch(name string, includeResults bool) (batch *Batch) {
+	return &Batch{
+		Id:             xid.New().String(),
+		Name:           name,
+		IncludeResults: includeResults,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// Batch represents a group of tasks that are tracked together. Once every task of the batch is finished,
+// failed or cancelled, the callback task is put into its queue, with the summary of the batch as its input.
+type Batch struct {
+	Id             string    // unique ID
+	Name           string    // custom name
+	Total          uint32    // number of the tasks
+	Succeeded      uint32    // number of the tasks that are finished successfully
+	Failed         uint32    // number of the tasks that failed, were dead-lettered or were cancelled
+	CallbackTaskId string    // ID of the task that is put into its queue once all tasks are settled
+	IncludeResults bool      // whether the summary includes outputs of the tasks
+	CreatedAt      time.Time // creation time
+	FinishedAt     time.Time // time all tasks were settled
+}
+
+// BatchTaskSpec describes a task of the batch being submitted, or its callback task.
+type BatchTaskSpec struct {
+	Queue    string            // name of the queue
+	Priority uint8             // priority level
+	Headers  map[string]string // custom key->value pairs
+	Input    []byte            // payload data, replaced with the summary of the batch for the callback task
+}
+
+// AddTask adds the task to the batch.
+func (batch *Batch) AddTask(task *Task) {
+	task.BatchId = batch.Id
+	batch.Total++
+}
+
+// SetCallback makes the task a callback of the batch, so it is waiting until all tasks of the batch are settled.
+func (batch *Batch) SetCallback(task *Task) {
+	task.Status = TaskStatusWaiting
+	task.Headers[TaskHeaderBatchId] = batch.Id
+	batch.CallbackTaskId = task.Id
+}
@@ -0,0 +1,189 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// cronMacros contains shortcuts for the commonly used cron expressions.
+	cronMacros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+
+	// cronFields contains bounds of the cron expression fields, in the order they appear in the expression.
+	cronFields = []struct {
+		name     string
+		min, max uint
+	}{
+		{"minute", 0, 59},
+		{"hour", 0, 23},
+		{"day of month", 1, 31},
+		{"month", 1, 12},
+		{"day of week", 0, 7},
+	}
+)
+
+const (
+	cronSearchLimit = 5 * 366 * 24 * time.Hour // max time span to look for the next firing time in
+)
+
+// ParseCron parses a standard 5-field cron expression (minute, hour, day of month, month, day of week).
+// Fields support wildcards, lists, ranges and steps, day of week accepts both 0 and 7 as Sunday.
+// As in Vixie cron, if both day of month and day of week are restricted, matching any of them is enough,
+// while a field starting with a wildcard (e.g. */2) does not count as restricted.
+// Macros like @hourly or @daily are supported as well. All times are evaluated in UTC.
+func ParseCron(expression string) (cron *Cron, err error) {
+
+	spec := strings.TrimSpace(expression)
+	if macro, ok := cronMacros[spec]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, errors.Errorf("expected %d fields, got %d", len(cronFields), len(fields))
+	}
+
+	var sets [5]uint64
+	for i, field := range fields {
+		sets[i], err = parseCronField(field, cronFields[i].min, cronFields[i].max)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s field", cronFields[i].name)
+		}
+	}
+
+	// Sunday can be written both as 0 and 7
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+
+	return &Cron{
+		minutes:     sets[0],
+		hours:       sets[1],
+		daysOfMonth: sets[2],
+		months:      sets[3],
+		daysOfWeek:  sets[4],
+		anyDom:      strings.HasPrefix(fields[2], "*"),
+		anyDow:      strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// Cron represents a parsed cron expression.
+type Cron struct {
+	minutes     uint64 // bit set of the matching minutes
+	hours       uint64 // bit set of the matching hours
+	daysOfMonth uint64 // bit set of the matching days of month
+	months      uint64 // bit set of the matching months
+	daysOfWeek  uint64 // bit set of the matching days of week
+	anyDom      bool   // whether day of month is not restricted
+	anyDow      bool   // whether day of week is not restricted
+}
+
+// Next returns the first firing time that is strictly after the given time.
+// Returns zero time if expression never fires (e.g. February 30).
+func (cron *Cron) Next(after time.Time) (next time.Time) {
+
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+	for t.Before(limit) {
+		switch {
+		case cron.months&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !cron.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case cron.hours&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+		case cron.minutes&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// matchDay checks whether the day of given time matches the expression.
+// If both day of month and day of week are restricted, matching any of them is enough.
+func (cron *Cron) matchDay(t time.Time) (match bool) {
+
+	dom := cron.daysOfMonth&(1<<uint(t.Day())) != 0
+	dow := cron.daysOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if cron.anyDom || cron.anyDow {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// parseCronField is a helper function that parses a single field of the cron expression into a bit set.
+func parseCronField(field string, min, max uint) (set uint64, err error) {
+
+	for _, part := range strings.Split(field, ",") {
+
+		// Parse step
+		rangePart, step := part, uint64(1)
+		if i := strings.Index(part, "/"); i >= 0 {
+			rangePart = part[:i]
+			step, err = strconv.ParseUint(part[i+1:], 10, 8)
+			if err != nil || step == 0 {
+				return 0, errors.Errorf("invalid step in %q", part)
+			}
+		}
+
+		// Parse range
+		low, high := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			low, err = parseCronValue(bounds[0], min, max)
+			if err != nil {
+				return 0, err
+			}
+			high, err = parseCronValue(bounds[1], min, max)
+			if err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, errors.Errorf("invalid range %q", rangePart)
+			}
+		default:
+			low, err = parseCronValue(rangePart, min, max)
+			if err != nil {
+				return 0, err
+			}
+			if step == 1 {
+				high = low
+			}
+		}
+
+		for value := low; value <= high; value += uint(step) {
+			set |= 1 << value
+		}
+	}
+
+	return
+}
+
+// parseCronValue is a helper function that parses a single value of the cron expression field.
+func parseCronValue(value string, min, max uint) (number uint, err error) {
+
+	parsed, err := strconv.ParseUint(value, 10, 8)
+	if err != nil || uint(parsed) < min || uint(parsed) > max {
+		return 0, errors.Errorf("value %q is out of range [%d, %d]", value, min, max)
+	}
+
+	return uint(parsed), nil
+}
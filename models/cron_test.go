@@ -0,0 +1,108 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+
+	cases := []struct {
+		expression string
+		valid      bool
+	}{
+		{"* * * * *", true},
+		{"*/15 0-23/2 1,15 1-12 0-7", true},
+		{"  @daily  ", true},
+		{"@weekly", true},
+		{"", false},
+		{"* * * *", false},
+		{"* * * * * *", false},
+		{"60 * * * *", false},
+		{"* 24 * * *", false},
+		{"* * 0 * *", false},
+		{"* * 32 * *", false},
+		{"* * * 13 *", false},
+		{"* * * * 8", false},
+		{"*/0 * * * *", false},
+		{"5-1 * * * *", false},
+		{"a * * * *", false},
+		{"1- * * * *", false},
+		{"@never", false},
+	}
+
+	for _, c := range cases {
+		_, err := ParseCron(c.expression)
+		if (err == nil) != c.valid {
+			t.Errorf("ParseCron(%q): unexpected error %v", c.expression, err)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+
+	// Thursday
+	after := time.Date(2024, 2, 28, 23, 59, 30, 0, time.UTC)
+
+	cases := []struct {
+		expression string
+		after      time.Time
+		next       string
+	}{
+		// wildcards and macros
+		{"* * * * *", after, "2024-02-29T00:00:00Z"},
+		{"@hourly", after, "2024-02-29T00:00:00Z"},
+		{"@monthly", after, "2024-03-01T00:00:00Z"},
+		{"@yearly", after, "2025-01-01T00:00:00Z"},
+
+		// next firing is strictly after the given time
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), "2028-02-29T00:00:00Z"},
+
+		// lists, ranges and steps
+		{"5,10 * * * *", after, "2024-02-29T00:05:00Z"},
+		{"*/15 * * * *", time.Date(2024, 2, 29, 0, 16, 0, 0, time.UTC), "2024-02-29T00:30:00Z"},
+		{"0 1-3/2 * * *", time.Date(2024, 2, 29, 1, 0, 0, 0, time.UTC), "2024-02-29T03:00:00Z"},
+		{"30 9 * * 1-5", after, "2024-02-29T09:30:00Z"},
+		{"0 12 * 4-6 *", after, "2024-04-01T12:00:00Z"},
+		{"0 0 31 * *", after, "2024-03-31T00:00:00Z"},
+
+		// day of week accepts both 0 and 7 as Sunday
+		{"0 12 * * 0", after, "2024-03-03T12:00:00Z"},
+		{"0 12 * * 7", after, "2024-03-03T12:00:00Z"},
+
+		// both days restricted: any of them matches
+		{"0 12 13 * 5", after, "2024-03-01T12:00:00Z"},
+		{"0 0 1 * 3", after, "2024-03-01T00:00:00Z"},
+
+		// wildcard-prefixed day field is not restricted: both of them must match
+		{"0 0 1 * */2", after, "2024-06-01T00:00:00Z"},
+		{"0 0 */10 * 6", after, "2024-05-11T00:00:00Z"},
+
+		// input in other time zone is evaluated in UTC
+		{"0 0 * * *", time.Date(2024, 2, 29, 1, 0, 0, 0, time.FixedZone("UTC+2", 2*3600)), "2024-02-29T00:00:00Z"},
+	}
+
+	for _, c := range cases {
+		cron, err := ParseCron(c.expression)
+		if err != nil {
+			t.Fatalf("ParseCron(%q): %v", c.expression, err)
+		}
+		next := cron.Next(c.after).Format(time.RFC3339)
+		if next != c.next {
+			t.Errorf("Next(%q, %s) = %s, want %s", c.expression, c.after.Format(time.RFC3339), next, c.next)
+		}
+	}
+}
+
+func TestCronNextNever(t *testing.T) {
+
+	for _, expression := range []string{"0 0 30 2 *", "0 0 31 4 *", "0 0 31 2,4,6,9,11 *"} {
+		cron, err := ParseCron(expression)
+		if err != nil {
+			t.Fatalf("ParseCron(%q): %v", expression, err)
+		}
+		if next := cron.Next(time.Now()); !next.IsZero() {
+			t.Errorf("Next(%q) = %s, want zero time", expression, next)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+const (
+	ExchangeTypeHeaders ExchangeType = iota // routes tasks to the queues whose binding rules match the task headers
+	ExchangeTypeFanout                      // routes tasks to all bound queues
+	ExchangeTypeDirect                      // routes tasks to the queues whose binding key equals the routing key
+	ExchangeTypeTopic                       // routes tasks to the queues whose binding pattern matches the routing key
+)
+
+// ExchangesRepository is an interface that all exchanges storage should implement.
+type ExchangesRepository interface {
+	// Save persists given exchange instance to the repo.
+	Save(ctx context.Context, record *Exchange) (err error)
+	// Delete removes exchange with given ID from the repo.
+	Delete(ctx context.Context, id string) (err error)
+	// GetById retrieves exchange with given ID from the repo.
+	GetById(ctx context.Context, id string) (record *Exchange, err error)
+	// GetByName retrieves exchange with given name from the repo.
+	GetByName(ctx context.Context, name string) (record *Exchange, err error)
+	// MGetById retrieves exchanges with given IDs from the repo.
+	MGetById(ctx context.Context, ids []string) (records []*Exchange, err error)
+	// Find returns a subset of the exchanges, based on collection params given.
+	Find(ctx context.Context, params *CollectionParams) (records []*Exchange, info *CollectionInfo, err error)
+}
+
+// NewExchange creates a new instance of Exchange.
+func NewExchange(name string, exchangeType ExchangeType) (exchange *Exchange) {
+	return &Exchange{
+		Id:        xid.New().String(),
+		Name:      name,
+		Type:      exchangeType,
+		CreatedAt: time.Now(),
+	}
+}
+
+// ExchangeType represents a routing algorithm of the exchange.
+type ExchangeType uint8
+
+// Exchange represents an input that accepts tasks from the publishers and routes them to the appropriate queues.
+type Exchange struct {
+	Id        string       // unique ID
+	Name      string       // unique name
+	Type      ExchangeType // routing algorithm
+	CreatedAt time.Time    // creation time
+}
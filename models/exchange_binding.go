@@ -0,0 +1,163 @@
+package models
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+)
+
+const (
+	ExchangeBindingRuleOperatorEquals              ExchangeBindingRuleOperator = iota // header equals the value
+	ExchangeBindingRuleOperatorNotEquals                                              // header is missing or differs from the value
+	ExchangeBindingRuleOperatorPrefix                                                 // header starts with the value
+	ExchangeBindingRuleOperatorRegex                                                  // header matches the regular expression
+	ExchangeBindingRuleOperatorIn                                                     // header equals one of the comma-separated values
+	ExchangeBindingRuleOperatorExists                                                 // header is present, the value is ignored
+	ExchangeBindingRuleOperatorGreaterThan                                            // header is a number greater than the value
+	ExchangeBindingRuleOperatorGreaterThanOrEquals                                    // header is a number greater than or equal to the value
+	ExchangeBindingRuleOperatorLessThan                                               // header is a number less than the value
+	ExchangeBindingRuleOperatorLessThanOrEquals                                       // header is a number less than or equal to the value
+)
+
+// ExchangeBindingsRepository is an interface that all exchange bindings storage should implement.
+type ExchangeBindingsRepository interface {
+	// Save persists given binding instance to the repo.
+	Save(ctx context.Context, record *ExchangeBinding) (err error)
+	// Delete removes binding with given ID from the repo.
+	Delete(ctx context.Context, id string) (err error)
+	// GetById retrieves binding with given ID from the repo.
+	GetById(ctx context.Context, id string) (record *ExchangeBinding, err error)
+	// FindByExchange returns all bindings of the exchange with given ID.
+	FindByExchange(ctx context.Context, exchangeId string) (records []*ExchangeBinding, err error)
+	// GetVersion returns a counter that changes every time a binding of the exchange with given ID is added or removed.
+	GetVersion(ctx context.Context, exchangeId string) (version int64, err error)
+}
+
+// NewExchangeBinding creates a new instance of ExchangeBinding.
+func NewExchangeBinding(
+	exchangeId string,
+	queueId string,
+	routingKey string,
+	rules []*ExchangeBindingRule,
+) (binding *ExchangeBinding) {
+	return &ExchangeBinding{
+		Id:         xid.New().String(),
+		ExchangeId: exchangeId,
+		QueueId:    queueId,
+		RoutingKey: routingKey,
+		Rules:      rules,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// ExchangeBindingRuleOperator represents a logical operator that is used in exchange binding rules.
+type ExchangeBindingRuleOperator uint8
+
+// ExchangeBinding represents a link between exchange and queue.
+type ExchangeBinding struct {
+	Id         string                 // unique ID
+	ExchangeId string                 // related exchange ID
+	QueueId    string                 // related queue ID
+	RoutingKey string                 // binding key of the direct exchange or binding pattern of the topic exchange
+	Rules      []*ExchangeBindingRule // routing rules of the headers exchange
+	CreatedAt  time.Time              // creation time
+}
+
+// ExchangeBindingRule represents a rule that should be checked.
+type ExchangeBindingRule struct {
+	Key      string                      // key of the header param
+	Operator ExchangeBindingRuleOperator // comparison operator
+	Value    string                      // comparison value
+}
+
+// Compile checks that the rule is valid and turns it into a matcher that is cheap to evaluate.
+func (rule *ExchangeBindingRule) Compile() (matcher *ExchangeBindingRuleMatcher, err error) {
+
+	matcher = &ExchangeBindingRuleMatcher{rule: rule}
+
+	switch rule.Operator {
+	case ExchangeBindingRuleOperatorEquals,
+		ExchangeBindingRuleOperatorNotEquals,
+		ExchangeBindingRuleOperatorPrefix,
+		ExchangeBindingRuleOperatorExists:
+	case ExchangeBindingRuleOperatorRegex:
+		matcher.regex, err = regexp.Compile(rule.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid regular expression")
+		}
+	case ExchangeBindingRuleOperatorIn:
+		matcher.list = make(map[string]bool)
+		for _, value := range strings.Split(rule.Value, ",") {
+			matcher.list[strings.TrimSpace(value)] = true
+		}
+	case ExchangeBindingRuleOperatorGreaterThan,
+		ExchangeBindingRuleOperatorGreaterThanOrEquals,
+		ExchangeBindingRuleOperatorLessThan,
+		ExchangeBindingRuleOperatorLessThanOrEquals:
+		matcher.number, err = strconv.ParseFloat(rule.Value, 64)
+		if err != nil {
+			return nil, errors.New("value is not a number")
+		}
+	default:
+		return nil, errors.Errorf("unknown operator %d", rule.Operator)
+	}
+
+	return
+}
+
+// ExchangeBindingRuleMatcher represents a compiled rule.
+type ExchangeBindingRuleMatcher struct {
+	rule   *ExchangeBindingRule // source rule
+	regex  *regexp.Regexp       // compiled regular expression of the regex operator
+	list   map[string]bool      // set of values of the in-list operator
+	number float64              // parsed value of the numeric operators
+}
+
+// Match checks whether given headers satisfy the rule.
+func (matcher *ExchangeBindingRuleMatcher) Match(headers map[string]string) (match bool) {
+
+	header, ok := headers[matcher.rule.Key]
+	switch matcher.rule.Operator {
+	case ExchangeBindingRuleOperatorNotEquals:
+		return !ok || header != matcher.rule.Value
+	case ExchangeBindingRuleOperatorExists:
+		return ok
+	}
+	if !ok {
+		return false
+	}
+
+	switch matcher.rule.Operator {
+	case ExchangeBindingRuleOperatorEquals:
+		return header == matcher.rule.Value
+	case ExchangeBindingRuleOperatorPrefix:
+		return strings.HasPrefix(header, matcher.rule.Value)
+	case ExchangeBindingRuleOperatorRegex:
+		return matcher.regex.MatchString(header)
+	case ExchangeBindingRuleOperatorIn:
+		return matcher.list[header]
+	}
+
+	// Numeric operators
+	number, err := strconv.ParseFloat(header, 64)
+	if err != nil {
+		return false
+	}
+	switch matcher.rule.Operator {
+	case ExchangeBindingRuleOperatorGreaterThan:
+		return number > matcher.number
+	case ExchangeBindingRuleOperatorGreaterThanOrEquals:
+		return number >= matcher.number
+	case ExchangeBindingRuleOperatorLessThan:
+		return number < matcher.number
+	case ExchangeBindingRuleOperatorLessThanOrEquals:
+		return number <= matcher.number
+	}
+
+	return false
+}
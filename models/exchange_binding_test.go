@@ -0,0 +1,108 @@
+package models
+
+import (
+	"testing"
+)
+
+func TestExchangeBindingRuleCompile(t *testing.T) {
+
+	cases := []struct {
+		operator ExchangeBindingRuleOperator
+		value    string
+		valid    bool
+	}{
+		{ExchangeBindingRuleOperatorEquals, "", true},
+		{ExchangeBindingRuleOperatorNotEquals, "", true},
+		{ExchangeBindingRuleOperatorPrefix, "", true},
+		{ExchangeBindingRuleOperatorExists, "", true},
+		{ExchangeBindingRuleOperatorRegex, "^a+$", true},
+		{ExchangeBindingRuleOperatorRegex, "(", false},
+		{ExchangeBindingRuleOperatorIn, "a,b", true},
+		{ExchangeBindingRuleOperatorGreaterThan, "1.5", true},
+		{ExchangeBindingRuleOperatorGreaterThan, "one", false},
+		{ExchangeBindingRuleOperatorGreaterThanOrEquals, "-1", true},
+		{ExchangeBindingRuleOperatorGreaterThanOrEquals, "", false},
+		{ExchangeBindingRuleOperatorLessThan, "1e3", true},
+		{ExchangeBindingRuleOperatorLessThan, "1,5", false},
+		{ExchangeBindingRuleOperatorLessThanOrEquals, "0", true},
+		{ExchangeBindingRuleOperatorLessThanOrEquals, "x", false},
+		{ExchangeBindingRuleOperatorLessThanOrEquals + 1, "", false},
+	}
+
+	for _, c := range cases {
+		rule := &ExchangeBindingRule{Key: "k", Operator: c.operator, Value: c.value}
+		matcher, err := rule.Compile()
+		if (err == nil) != c.valid || (matcher != nil) != c.valid {
+			t.Errorf("Compile(%d, %q): unexpected result %v, %v", c.operator, c.value, matcher, err)
+		}
+	}
+}
+
+func TestExchangeBindingRuleMatch(t *testing.T) {
+
+	headers := map[string]string{
+		"text":   "hello",
+		"number": "12.5",
+		"empty":  "",
+	}
+
+	cases := []struct {
+		key      string
+		operator ExchangeBindingRuleOperator
+		value    string
+		match    bool
+	}{
+		{"text", ExchangeBindingRuleOperatorEquals, "hello", true},
+		{"text", ExchangeBindingRuleOperatorEquals, "Hello", false},
+		{"missing", ExchangeBindingRuleOperatorEquals, "", false},
+		{"empty", ExchangeBindingRuleOperatorEquals, "", true},
+
+		{"text", ExchangeBindingRuleOperatorNotEquals, "hello", false},
+		{"text", ExchangeBindingRuleOperatorNotEquals, "world", true},
+		{"missing", ExchangeBindingRuleOperatorNotEquals, "hello", true},
+
+		{"text", ExchangeBindingRuleOperatorPrefix, "he", true},
+		{"text", ExchangeBindingRuleOperatorPrefix, "", true},
+		{"text", ExchangeBindingRuleOperatorPrefix, "lo", false},
+		{"missing", ExchangeBindingRuleOperatorPrefix, "", false},
+
+		{"text", ExchangeBindingRuleOperatorRegex, "^h.l+o$", true},
+		{"text", ExchangeBindingRuleOperatorRegex, "ell", true},
+		{"text", ExchangeBindingRuleOperatorRegex, "^ell", false},
+		{"missing", ExchangeBindingRuleOperatorRegex, ".*", false},
+
+		{"text", ExchangeBindingRuleOperatorIn, "x, hello ,y", true},
+		{"text", ExchangeBindingRuleOperatorIn, "x,y", false},
+		{"text", ExchangeBindingRuleOperatorIn, "hell", false},
+		{"missing", ExchangeBindingRuleOperatorIn, "", false},
+
+		{"empty", ExchangeBindingRuleOperatorExists, "", true},
+		{"text", ExchangeBindingRuleOperatorExists, "ignored", true},
+		{"missing", ExchangeBindingRuleOperatorExists, "", false},
+
+		{"number", ExchangeBindingRuleOperatorGreaterThan, "12", true},
+		{"number", ExchangeBindingRuleOperatorGreaterThan, "12.5", false},
+		{"number", ExchangeBindingRuleOperatorGreaterThanOrEquals, "12.5", true},
+		{"number", ExchangeBindingRuleOperatorGreaterThanOrEquals, "13", false},
+		{"number", ExchangeBindingRuleOperatorLessThan, "13", true},
+		{"number", ExchangeBindingRuleOperatorLessThan, "12.5", false},
+		{"number", ExchangeBindingRuleOperatorLessThanOrEquals, "12.5", true},
+		{"number", ExchangeBindingRuleOperatorLessThanOrEquals, "12", false},
+
+		// non-numeric or missing headers never match numeric operators
+		{"text", ExchangeBindingRuleOperatorLessThan, "13", false},
+		{"empty", ExchangeBindingRuleOperatorGreaterThanOrEquals, "0", false},
+		{"missing", ExchangeBindingRuleOperatorLessThanOrEquals, "13", false},
+	}
+
+	for _, c := range cases {
+		rule := &ExchangeBindingRule{Key: c.key, Operator: c.operator, Value: c.value}
+		matcher, err := rule.Compile()
+		if err != nil {
+			t.Fatalf("Compile(%d, %q): %v", c.operator, c.value, err)
+		}
+		if match := matcher.Match(headers); match != c.match {
+			t.Errorf("Match(%q %d %q) = %v, want %v", c.key, c.operator, c.value, match, c.match)
+		}
+	}
+}
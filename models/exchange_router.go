@@ -0,0 +1,149 @@
+package models
+
+import (
+	"strings"
+)
+
+const (
+	ExchangeTopicWordSeparator = "." // separates words of the topic routing keys
+	ExchangeTopicWildcardOne   = "*" // matches exactly one word of the topic routing key
+	ExchangeTopicWildcardAny   = "#" // matches zero or more words of the topic routing key
+)
+
+// NewExchangeRouter creates a new instance of ExchangeRouter for the exchange and its bindings given.
+// Bindings are compiled once, so the router can be reused for any number of tasks until the bindings change.
+// Bindings with invalid rules never match.
+func NewExchangeRouter(exchange *Exchange, bindings []*ExchangeBinding) (router *ExchangeRouter) {
+
+	router = &ExchangeRouter{
+		exchangeType: exchange.Type,
+		direct:       make(map[string][]string),
+		topic:        newExchangeTopicNode(),
+	}
+
+bindings:
+	for _, binding := range bindings {
+		switch exchange.Type {
+		case ExchangeTypeFanout:
+			router.fanout = append(router.fanout, binding.QueueId)
+		case ExchangeTypeDirect:
+			router.direct[binding.RoutingKey] = append(router.direct[binding.RoutingKey], binding.QueueId)
+		case ExchangeTypeTopic:
+			router.topic.insert(strings.Split(binding.RoutingKey, ExchangeTopicWordSeparator), binding.QueueId)
+		default:
+			route := exchangeRoute{queueId: binding.QueueId}
+			for _, rule := range binding.Rules {
+				matcher, err := rule.Compile()
+				if err != nil {
+					continue bindings
+				}
+				route.matchers = append(route.matchers, matcher)
+			}
+			router.headers = append(router.headers, route)
+		}
+	}
+
+	return
+}
+
+// ExchangeRouter matches published tasks against bindings of the exchange.
+type ExchangeRouter struct {
+	exchangeType ExchangeType        // routing algorithm
+	fanout       []string            // IDs of the bound queues of the fanout exchange
+	direct       map[string][]string // binding key => IDs of the bound queues of the direct exchange
+	topic        *exchangeTopicNode  // trie of the binding patterns of the topic exchange
+	headers      []exchangeRoute     // compiled bindings of the headers exchange
+}
+
+// exchangeRoute represents a compiled binding of the headers exchange.
+type exchangeRoute struct {
+	queueId  string                        // related queue ID
+	matchers []*ExchangeBindingRuleMatcher // compiled rules, all of them must match
+}
+
+// Route returns IDs of the queues the task with given routing key and headers should be put into.
+// Every queue is returned once, even if several of its bindings match.
+func (router *ExchangeRouter) Route(routingKey string, headers map[string]string) (queueIds []string) {
+
+	seen := make(map[string]bool)
+	collect := func(ids []string) {
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				queueIds = append(queueIds, id)
+			}
+		}
+	}
+
+	switch router.exchangeType {
+	case ExchangeTypeFanout:
+		collect(router.fanout)
+	case ExchangeTypeDirect:
+		collect(router.direct[routingKey])
+	case ExchangeTypeTopic:
+		router.topic.match(strings.Split(routingKey, ExchangeTopicWordSeparator), collect)
+	default:
+	routes:
+		for _, route := range router.headers {
+			for _, matcher := range route.matchers {
+				if !matcher.Match(headers) {
+					continue routes
+				}
+			}
+			collect([]string{route.queueId})
+		}
+	}
+
+	return
+}
+
+// newExchangeTopicNode creates a new instance of exchangeTopicNode.
+func newExchangeTopicNode() (node *exchangeTopicNode) {
+	return &exchangeTopicNode{
+		children: make(map[string]*exchangeTopicNode),
+	}
+}
+
+// exchangeTopicNode represents a node of the trie of the topic binding patterns, one level per word.
+// Lookup cost depends on the length of the routing key and the number of wildcards, not on the number of bindings.
+type exchangeTopicNode struct {
+	children map[string]*exchangeTopicNode // next word (or wildcard) => child node
+	queueIds []string                      // IDs of the queues whose patterns end at this node
+}
+
+// insert adds the pattern given as a list of words to the trie.
+func (node *exchangeTopicNode) insert(words []string, queueId string) {
+
+	for _, word := range words {
+		child, ok := node.children[word]
+		if !ok {
+			child = newExchangeTopicNode()
+			node.children[word] = child
+		}
+		node = child
+	}
+	node.queueIds = append(node.queueIds, queueId)
+}
+
+// match calls collect for every node of the trie whose pattern matches the routing key given as a list of words.
+func (node *exchangeTopicNode) match(words []string, collect func(ids []string)) {
+
+	// Any-words wildcard matches the rest of the key starting from every position, including the end
+	if child, ok := node.children[ExchangeTopicWildcardAny]; ok {
+		for i := 0; i <= len(words); i++ {
+			child.match(words[i:], collect)
+		}
+	}
+
+	if len(words) == 0 {
+		collect(node.queueIds)
+		return
+	}
+
+	if child, ok := node.children[words[0]]; ok {
+		child.match(words[1:], collect)
+	}
+	if child, ok := node.children[ExchangeTopicWildcardOne]; ok {
+		child.match(words[1:], collect)
+	}
+}
@@ -0,0 +1,130 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestExchangeRouterTopic(t *testing.T) {
+
+	router := NewExchangeRouter(&Exchange{Type: ExchangeTypeTopic}, []*ExchangeBinding{
+		{QueueId: "a", RoutingKey: "orders.*.created"},
+		{QueueId: "b", RoutingKey: "orders.#"},
+		{QueueId: "c", RoutingKey: "#"},
+		{QueueId: "d", RoutingKey: "*.eu.#.done"},
+		{QueueId: "a", RoutingKey: "#.created"},
+		{QueueId: "e", RoutingKey: "*"},
+		{QueueId: "f", RoutingKey: "users.*"},
+	})
+
+	cases := []struct {
+		routingKey string
+		queueIds   string
+	}{
+		{"orders.eu.created", "a,b,c"}, // queue matched by several patterns is returned once
+		{"orders.eu.x.created", "a,b,c"},
+		{"orders", "b,c,e"}, // any-words wildcard matches zero words
+		{"orders.eu", "b,c"},
+		{"created", "a,c,e"},
+		{"users.eu.done", "c,d"},
+		{"users.eu.x.y.done", "c,d"},
+		{"users.us.done", "c"},
+		{"users.eu", "c,f"},
+		{"users", "c,e"},
+		{"", "c,e"},
+	}
+
+	for _, c := range cases {
+		queueIds := router.Route(c.routingKey, nil)
+		sort.Strings(queueIds)
+		if strings.Join(queueIds, ",") != c.queueIds {
+			t.Errorf("Route(%q) = %v, want %s", c.routingKey, queueIds, c.queueIds)
+		}
+	}
+}
+
+func TestExchangeRouterDirect(t *testing.T) {
+
+	router := NewExchangeRouter(&Exchange{Type: ExchangeTypeDirect}, []*ExchangeBinding{
+		{QueueId: "a", RoutingKey: "k"},
+		{QueueId: "b", RoutingKey: "k"},
+		{QueueId: "a", RoutingKey: "k"},
+		{QueueId: "c", RoutingKey: "orders.*"},
+	})
+
+	cases := []struct {
+		routingKey string
+		queueIds   string
+	}{
+		{"k", "a,b"},
+		{"K", ""},
+		{"orders.*", "c"},
+		{"orders.eu", ""}, // wildcards are not expanded
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		queueIds := router.Route(c.routingKey, nil)
+		sort.Strings(queueIds)
+		if strings.Join(queueIds, ",") != c.queueIds {
+			t.Errorf("Route(%q) = %v, want %s", c.routingKey, queueIds, c.queueIds)
+		}
+	}
+}
+
+func TestExchangeRouterFanout(t *testing.T) {
+
+	router := NewExchangeRouter(&Exchange{Type: ExchangeTypeFanout}, []*ExchangeBinding{
+		{QueueId: "a", RoutingKey: "ignored"},
+		{QueueId: "b"},
+		{QueueId: "a"},
+	})
+
+	for _, routingKey := range []string{"", "ignored", "anything"} {
+		queueIds := router.Route(routingKey, nil)
+		sort.Strings(queueIds)
+		if strings.Join(queueIds, ",") != "a,b" {
+			t.Errorf("Route(%q) = %v, want a,b", routingKey, queueIds)
+		}
+	}
+
+	if queueIds := NewExchangeRouter(&Exchange{Type: ExchangeTypeFanout}, nil).Route("", nil); len(queueIds) != 0 {
+		t.Errorf("Route() without bindings = %v, want none", queueIds)
+	}
+}
+
+func TestExchangeRouterHeaders(t *testing.T) {
+
+	router := NewExchangeRouter(&Exchange{Type: ExchangeTypeHeaders}, []*ExchangeBinding{
+		{QueueId: "a", Rules: []*ExchangeBindingRule{
+			{Key: "type", Operator: ExchangeBindingRuleOperatorEquals, Value: "order"},
+			{Key: "amount", Operator: ExchangeBindingRuleOperatorGreaterThan, Value: "100"},
+		}},
+		{QueueId: "b", Rules: []*ExchangeBindingRule{
+			{Key: "type", Operator: ExchangeBindingRuleOperatorExists},
+		}},
+		{QueueId: "c", Rules: []*ExchangeBindingRule{
+			{Key: "type", Operator: ExchangeBindingRuleOperatorRegex, Value: "("}, // invalid, never matches
+		}},
+		{QueueId: "d"}, // no rules, always matches
+	})
+
+	cases := []struct {
+		headers  map[string]string
+		queueIds string
+	}{
+		{map[string]string{"type": "order", "amount": "150"}, "a,b,d"},
+		{map[string]string{"type": "order", "amount": "50"}, "b,d"},
+		{map[string]string{"amount": "150"}, "d"},
+		{nil, "d"},
+	}
+
+	for _, c := range cases {
+		queueIds := router.Route("", c.headers)
+		sort.Strings(queueIds)
+		if strings.Join(queueIds, ",") != c.queueIds {
+			t.Errorf("Route(%v) = %v, want %s", c.headers, queueIds, c.queueIds)
+		}
+	}
+}
@@ -1,13 +1,56 @@
 package models
 
-import "time"
+import (
+	"context"
+	"time"
+)
+
+// JobsRepository is an interface that all jobs storage should implement.
+type JobsRepository interface {
+	// Save persists given job instance to the repo.
+	Save(ctx context.Context, record *Job) (err error)
+	// GetById retrieves job with given ID from the repo.
+	GetById(ctx context.Context, id string) (record *Job, err error)
+	// Finish atomically sets finish time of the job with given ID, if it is not finished yet.
+	// Returns false if the job does not exist or is already finished.
+	Finish(ctx context.Context, id string, finishedAt time.Time) (finished bool, err error)
+	// UpdateProgress atomically sets progress of the job with given ID, if it is not finished yet.
+	// Returns false if the job does not exist or is already finished.
+	UpdateProgress(ctx context.Context, id string, progress uint8) (updated bool, err error)
+	// AppendLogs atomically appends lines to the log of the job with given ID, if it is not finished yet.
+	// Returns false if the job does not exist or is already finished.
+	AppendLogs(ctx context.Context, id string, lines []string) (appended bool, err error)
+	// GetLogs retrieves log lines of the job with given ID, starting from given offset.
+	GetLogs(ctx context.Context, id string, offset int) (lines []string, err error)
+}
+
+// NewJob creates a new instance of Job for the task given.
+// Job ID is taken from the task lease.
+func NewJob(task *Task) (job *Job) {
+
+	now := time.Now()
+
+	return &Job{
+		Id:          task.JobId,
+		TaskId:      task.Id,
+		QueueId:     task.QueueId,
+		Headers:     task.Headers,
+		Input:       task.Input,
+		CreatedAt:   now,
+		DeliveredAt: now,
+	}
+}
 
 // Job represents a single unit of work that is delivered to the worker.
 type Job struct {
-	Id          string // unique ID
-	Input       []byte // task payload
-	Progress    uint8
-	Logs        []string
+	Id          string            // unique ID
+	TaskId      string            // related task ID
+	QueueId     string            // related queue ID
+	WorkerId    string            // ID of the registered worker the job is delivered to, if any
+	Headers     map[string]string // task headers
+	Input       []byte            // task payload
+	Progress    uint8             // progress percentage reported by the worker
+	Logs        []string          // log lines reported by the worker
 	CreatedAt   time.Time
 	DeliveredAt time.Time
 	FinishedAt  time.Time
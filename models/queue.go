@@ -12,6 +12,27 @@ const (
 	QueueSettingRateLimitEnabled  QueueSetting = "rate-limit.enabled"
 	QueueSettingRateLimitTokens   QueueSetting = "rate-limit.tokens"
 	QueueSettingRateLimitDuration QueueSetting = "rate-limit.duration"
+	QueueSettingVisibilityTimeout QueueSetting = "visibility-timeout"
+	QueueSettingPriorityMode      QueueSetting = "priority.mode"
+	QueueSettingPriorityWeight    QueueSetting = "priority.weight"
+
+	QueueSettingRetryMaxAttempts       QueueSetting = "retry.max-attempts"
+	QueueSettingRetryBackoffInitial    QueueSetting = "retry.backoff.initial"
+	QueueSettingRetryBackoffMultiplier QueueSetting = "retry.backoff.multiplier"
+	QueueSettingRetryBackoffMax        QueueSetting = "retry.backoff.max"
+	QueueSettingRetryBackoffJitter     QueueSetting = "retry.backoff.jitter"
+
+	QueueSettingDeadLetterQueue QueueSetting = "dead-letter.queue"
+
+	QueueSettingResultTtl QueueSetting = "result.ttl"
+
+	QueueSettingIdempotencyWindow QueueSetting = "idempotency.window"
+
+	// QueuePriorityModeStrict makes queue always deliver tasks with higher priority first.
+	QueuePriorityModeStrict = "strict"
+	// QueuePriorityModeWeighted makes queue give tasks with higher priority a head start that is proportional
+	// to the priority, so tasks with lower priority are delivered once they wait long enough.
+	QueuePriorityModeWeighted = "weighted"
 )
 
 var (
@@ -19,6 +40,21 @@ var (
 		QueueSettingRateLimitEnabled:  "0",
 		QueueSettingRateLimitTokens:   "0",
 		QueueSettingRateLimitDuration: "0",
+		QueueSettingVisibilityTimeout: "30",
+		QueueSettingPriorityMode:      QueuePriorityModeStrict,
+		QueueSettingPriorityWeight:    "60",
+
+		QueueSettingRetryMaxAttempts:       "0",
+		QueueSettingRetryBackoffInitial:    "0",
+		QueueSettingRetryBackoffMultiplier: "2",
+		QueueSettingRetryBackoffMax:        "3600",
+		QueueSettingRetryBackoffJitter:     "0",
+
+		QueueSettingDeadLetterQueue: "",
+
+		QueueSettingResultTtl: "86400",
+
+		QueueSettingIdempotencyWindow: "86400",
 	}
 )
 
@@ -36,6 +72,13 @@ type QueuesRepository interface {
 	MGetById(ctx context.Context, ids []string) (records []*Queue, err error)
 	// Find returns a subset of the queries, based on collection params given.
 	Find(ctx context.Context, params *CollectionParams) (records []*Queue, info *CollectionInfo, err error)
+	// UpdateSettings overwrites given settings of the queue with given ID and increments its version,
+	// if the queue is still at given version. Settings that are not given are kept intact.
+	// Returns false if the queue does not exist or its version differs.
+	UpdateSettings(ctx context.Context, id string, settings map[QueueSetting]string, version int64) (updated bool, err error)
+	// SetPaused pauses or resumes delivery of the tasks of the queue with given ID.
+	// Returns false if the queue does not exist.
+	SetPaused(ctx context.Context, id string, paused bool) (updated bool, err error)
 }
 
 // NewQueue creates a new instance of Queue.
@@ -43,7 +86,7 @@ func NewQueue(name string, settings map[QueueSetting]string) (queue *Queue) {
 	return &Queue{
 		Id:        xid.New().String(),
 		Name:      name,
-		Settings:  mergeSettings(defaultSettings, settings),
+		Settings:  mergeSettings(DefaultQueueSettings(), settings),
 		CreatedAt: time.Now(),
 	}
 }
@@ -53,12 +96,41 @@ type Queue struct {
 	Id        string                  // unique ID
 	Name      string                  // unique name
 	Settings  map[QueueSetting]string // settings
+	Paused    bool                    // whether the queue holds its tasks back from the consumers
+	Version   int64                   // counter that is incremented on every settings update
 	CreatedAt time.Time               // creation time
 }
 
 // QueueSetting represents an identifier of the query setting.
 type QueueSetting string
 
+// QueueStats represents live statistics of the queue.
+type QueueStats struct {
+	Pending          uint64        // number of the tasks waiting to be delivered
+	Delayed          uint64        // number of the tasks waiting for their run time
+	Processing       uint64        // number of the tasks leased to the jobs
+	Finished         uint64        // number of the tasks finished successfully
+	Failed           uint64        // number of the tasks that failed permanently
+	Expired          uint64        // number of the tasks that expired before delivery
+	DeadLettered     uint64        // number of the tasks moved to the dead-letter queue
+	Enqueued         uint64        // number of the tasks put to the queue
+	Dequeued         uint64        // number of the tasks leased to the jobs
+	EnqueueRate      float64       // number of the tasks put to the queue per second, over the last minute
+	DequeueRate      float64       // number of the tasks leased to the jobs per second, over the last minute
+	OldestPendingAge time.Duration // time the oldest pending task has been waiting for delivery
+}
+
+// DefaultQueueSettings returns a copy of the settings that are applied to the queues by default.
+func DefaultQueueSettings() (settings map[QueueSetting]string) {
+
+	settings = make(map[QueueSetting]string, len(defaultSettings))
+	for key, value := range defaultSettings {
+		settings[key] = value
+	}
+
+	return
+}
+
 // mergeSettings is a tiny helper that merges default and custom queue settings.
 func mergeSettings(defaultSettings, customSettings map[QueueSetting]string) (merged map[QueueSetting]string) {
 	for key, value := range customSettings {
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// NewRetryPolicy creates a new instance of RetryPolicy from the queue settings given.
+// Missing or malformed settings fall back to the default ones.
+func NewRetryPolicy(settings map[QueueSetting]string) (policy *RetryPolicy) {
+
+	merged := mergeSettings(DefaultQueueSettings(), settings)
+	parseInt := func(setting QueueSetting) int64 {
+		value, err := strconv.ParseInt(merged[setting], 10, 64)
+		if err != nil {
+			value, _ = strconv.ParseInt(defaultSettings[setting], 10, 64)
+		}
+		return value
+	}
+	parseFloat := func(setting QueueSetting) float64 {
+		value, err := strconv.ParseFloat(merged[setting], 64)
+		if err != nil {
+			value, _ = strconv.ParseFloat(defaultSettings[setting], 64)
+		}
+		return value
+	}
+
+	return &RetryPolicy{
+		MaxAttempts:    uint32(parseInt(QueueSettingRetryMaxAttempts)),
+		InitialBackoff: time.Duration(parseInt(QueueSettingRetryBackoffInitial)) * time.Second,
+		Multiplier:     parseFloat(QueueSettingRetryBackoffMultiplier),
+		MaxBackoff:     time.Duration(parseInt(QueueSettingRetryBackoffMax)) * time.Second,
+		Jitter:         parseFloat(QueueSettingRetryBackoffJitter),
+	}
+}
+
+// RetryPolicy describes how failed tasks of the queue are retried.
+type RetryPolicy struct {
+	MaxAttempts    uint32        // max number of processing attempts, 0 means no limit
+	InitialBackoff time.Duration // delay before the first retry
+	Multiplier     float64       // factor the delay is multiplied by after every retry
+	MaxBackoff     time.Duration // max delay before the retry
+	Jitter         float64       // max fraction of the delay that is randomly subtracted from it
+}
+
+// Exhausted checks whether the task that failed given number of processing attempts should not be retried anymore.
+func (policy *RetryPolicy) Exhausted(attempts uint32) (exhausted bool) {
+	return policy.MaxAttempts > 0 && attempts >= policy.MaxAttempts
+}
+
+// Backoff returns a delay before the retry of the task that failed given number of processing attempts.
+// Random value in range [0, 1) is used to apply the jitter.
+func (policy *RetryPolicy) Backoff(attempts uint32, random float64) (delay time.Duration) {
+
+	if attempts == 0 {
+		return 0
+	}
+
+	backoff := float64(policy.InitialBackoff) * math.Pow(policy.Multiplier, float64(attempts-1))
+	if backoff > float64(policy.MaxBackoff) {
+		backoff = float64(policy.MaxBackoff)
+	}
+
+	return time.Duration(backoff * (1 - policy.Jitter*random))
+}
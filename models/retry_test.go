@@ -0,0 +1,101 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewRetryPolicy(t *testing.T) {
+
+	policy := NewRetryPolicy(map[QueueSetting]string{
+		QueueSettingRetryMaxAttempts:       "3",
+		QueueSettingRetryBackoffInitial:    "2",
+		QueueSettingRetryBackoffMultiplier: "malformed",
+	})
+
+	defaults := NewRetryPolicy(nil)
+	if policy.MaxAttempts != 3 || policy.InitialBackoff != 2*time.Second {
+		t.Errorf("custom settings are not applied: %+v", policy)
+	}
+	if policy.Multiplier != defaults.Multiplier || policy.MaxBackoff != defaults.MaxBackoff {
+		t.Errorf("malformed or missing settings do not fall back to defaults: %+v", policy)
+	}
+}
+
+func TestRetryPolicyExhausted(t *testing.T) {
+
+	cases := []struct {
+		maxAttempts uint32
+		attempts    uint32
+		exhausted   bool
+	}{
+		{3, 0, false},
+		{3, 2, false},
+		{3, 3, true},
+		{3, 4, true},
+		{1, 1, true},
+		{0, 0, false},
+		{0, 1000000, false}, // no limit
+	}
+
+	for _, c := range cases {
+		policy := &RetryPolicy{MaxAttempts: c.maxAttempts}
+		if exhausted := policy.Exhausted(c.attempts); exhausted != c.exhausted {
+			t.Errorf("Exhausted(%d) with max %d = %v, want %v", c.attempts, c.maxAttempts, exhausted, c.exhausted)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+
+	policy := &RetryPolicy{
+		InitialBackoff: time.Second,
+		Multiplier:     2,
+		MaxBackoff:     10 * time.Second,
+		Jitter:         0.5,
+	}
+
+	cases := []struct {
+		attempts uint32
+		random   float64
+		delay    time.Duration
+	}{
+		{0, 0, 0},
+		{1, 0, time.Second},
+		{2, 0, 2 * time.Second},
+		{4, 0, 8 * time.Second},
+		{5, 0, 10 * time.Second},   // capped
+		{100, 0, 10 * time.Second}, // capped, no overflow
+		{1, 0.5, 750 * time.Millisecond},
+		{5, 0.5, 7500 * time.Millisecond},
+	}
+
+	for _, c := range cases {
+		if delay := policy.Backoff(c.attempts, c.random); delay != c.delay {
+			t.Errorf("Backoff(%d, %v) = %s, want %s", c.attempts, c.random, delay, c.delay)
+		}
+	}
+}
+
+func TestRetryPolicyBackoffJitterBounds(t *testing.T) {
+
+	for _, jitter := range []float64{0, 0.25, 1} {
+		policy := &RetryPolicy{
+			InitialBackoff: time.Second,
+			Multiplier:     3,
+			MaxBackoff:     time.Minute,
+			Jitter:         jitter,
+		}
+		for attempts := uint32(1); attempts <= 10; attempts++ {
+			full := policy.Backoff(attempts, 0)
+			for _, random := range []float64{0, 0.1, 0.5, 0.999} {
+				delay := policy.Backoff(attempts, random)
+				min := time.Duration(float64(full) * (1 - jitter))
+				if delay > full || delay < min || delay < 0 {
+					t.Errorf("Backoff(%d, %v) with jitter %v = %s, want within [%s, %s]",
+						attempts, random, jitter, delay, min, full)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+// SchedulesRepository is an interface that all schedules storage should implement.
+type SchedulesRepository interface {
+	// Save persists given schedule instance to the repo.
+	Save(ctx context.Context, record *Schedule) (err error)
+	// Delete removes schedule with given ID from the repo.
+	Delete(ctx context.Context, id string) (err error)
+	// GetById retrieves schedule with given ID from the repo.
+	GetById(ctx context.Context, id string) (record *Schedule, err error)
+	// MGetById retrieves schedules with given IDs from the repo.
+	MGetById(ctx context.Context, ids []string) (records []*Schedule, err error)
+	// Find returns a subset of the schedules, based on collection params given.
+	Find(ctx context.Context, params *CollectionParams) (records []*Schedule, info *CollectionInfo, err error)
+	// FindDue returns up to limit schedules whose next run time is not after given time.
+	FindDue(ctx context.Context, now time.Time, limit int) (records []*Schedule, err error)
+	// Advance atomically moves next run time of the schedule with given ID from the previous value to the next one.
+	// Returns false if the schedule does not exist or its next run time is not the previous value anymore.
+	Advance(ctx context.Context, id string, prevRunAt, nextRunAt time.Time) (advanced bool, err error)
+}
+
+// NewSchedule creates a new instance of Schedule.
+func NewSchedule(
+	queueId string,
+	expression string,
+	priority uint8,
+	headers map[string]string,
+	input []byte,
+	nextRunAt time.Time,
+) (schedule *Schedule) {
+
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+
+	return &Schedule{
+		Id:         xid.New().String(),
+		QueueId:    queueId,
+		Expression: expression,
+		Priority:   priority,
+		Headers:    headers,
+		Input:      input,
+		NextRunAt:  nextRunAt,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// NewScheduledTask creates a new instance of Task for the schedule firing at given time.
+// Task ID is derived from the schedule ID and the firing time, so every firing produces exactly one task.
+func NewScheduledTask(schedule *Schedule, firedAt time.Time) (task *Task) {
+
+	headers := make(map[string]string, len(schedule.Headers))
+	for key, value := range schedule.Headers {
+		headers[key] = value
+	}
+
+	task = NewTask(schedule.QueueId, schedule.Priority, headers, schedule.Input, time.Time{}, firedAt)
+	task.Id = schedule.Id + "-" + strconv.FormatInt(firedAt.Unix(), 10)
+
+	return
+}
+
+// Schedule represents a recurring task template that is put into the queue at every firing time of the cron expression.
+type Schedule struct {
+	Id         string            // unique ID
+	QueueId    string            // related queue ID
+	Expression string            // cron expression
+	Priority   uint8             // priority level of the tasks
+	Headers    map[string]string // custom key->value pairs of the tasks
+	Input      []byte            // payload data of the tasks
+	NextRunAt  time.Time         // next firing time
+	CreatedAt  time.Time         // creation time
+}
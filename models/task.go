@@ -1,25 +1,185 @@
 package models
 
-import "time"
+import (
+	"context"
+	"time"
+
+	"github.com/rs/xid"
+)
 
 const (
 	TaskStatusPending TaskStatus = iota
 	TaskStatusProcessing
 	TaskStatusExpired
 	TaskStatusFinished
+	TaskStatusCancelled
+	TaskStatusFailed
+	TaskStatusDelayed
+	TaskStatusWaiting
+)
+
+const (
+	TaskHeaderDeadLetterReason = "x-dead-letter-reason" // why the task was dead-lettered, see DeadLetterReason* constants
+	TaskHeaderDeadLetterError  = "x-dead-letter-error"  // reason of the last failed processing attempt
+	TaskHeaderDeadLetteredAt   = "x-dead-lettered-at"   // time the task was dead-lettered
+	TaskHeaderOriginalQueue    = "x-original-queue"     // name of the queue the task was dead-lettered from
+	TaskHeaderAttempts         = "x-attempts"           // number of failed processing attempts in the original queue
+	TaskHeaderAttemptErrors    = "x-attempt-errors"     // JSON list of the reasons of the failed processing attempts
+	TaskHeaderBatchId          = "x-batch-id"           // ID of the batch the callback task reports on
+
+	DeadLetterReasonRejected  = "rejected"  // task was rejected by the worker
+	DeadLetterReasonExhausted = "exhausted" // task ran out of retry attempts
 )
 
+// TasksRepository is an interface that all tasks storage should implement.
+type TasksRepository interface {
+	// Enqueue persists given task instance to the repo and puts it into the pending list of its queue,
+	// or into the delayed list if the task is delayed.
+	// Returns false if the task with the same ID already exists, so tasks with deterministic IDs are enqueued once.
+	Enqueue(ctx context.Context, record *Task) (enqueued bool, err error)
+	// EnqueueIdempotent persists given task instance the same way Enqueue does, unless another task was enqueued
+	// to the same queue with the same idempotency key within given window. Key of the task that does not exist
+	// anymore (e.g. it was purged) is free again. If the task has uniqueness key, it is checked after
+	// the idempotency key the same way EnqueueUnique does, and the idempotency key is not taken if it is held.
+	// Returns ID of the task that holds the key, which is ID of the given task if it was enqueued.
+	EnqueueIdempotent(ctx context.Context, record *Task, idempotencyKey string, window time.Duration) (id string, err error)
+	// EnqueueUnique persists given task instance the same way Enqueue does, unless another task of the same queue
+	// holding the same uniqueness key is still pending, delayed or processing. The key is released once its task
+	// is finished, failed, cancelled, expired or dead-lettered.
+	// Returns ID of the task that holds the key, which is ID of the given task if it was enqueued.
+	EnqueueUnique(ctx context.Context, record *Task) (id string, err error)
+	// GetById retrieves task with given ID from the repo.
+	GetById(ctx context.Context, id string) (record *Task, err error)
+	// MGetById retrieves tasks with given IDs from the repo.
+	MGetById(ctx context.Context, ids []string) (records []*Task, err error)
+	// Dequeue atomically takes the next pending task of the queue with given ID, marks it as processing
+	// and leases it to a new job until the visibility timeout of the queue passes.
+	// ID of the new job is set to the JobId field of the task.
+	// Returns nil if there are no pending tasks, the queue is paused or the rate limit of the queue is exceeded.
+	Dequeue(ctx context.Context, queueId string) (record *Task, err error)
+	// UpdateStatus changes status of the task with given ID.
+	UpdateStatus(ctx context.Context, id string, status TaskStatus) (err error)
+	// Finish marks the task with given ID as finished with given final status and sets its finish time,
+	// if the job with given ID still holds the task lease. Output is kept until the result TTL of the queue passes.
+	// Successfully finished task unlocks its workflow children, so those whose parents are all finished
+	// are put into the pending lists of their queues. Returns false if the lease is lost.
+	Finish(ctx context.Context, id string, jobId string, status TaskStatus, output []byte) (finished bool, err error)
+	// Requeue puts the task with given ID back to the pending list of its queue,
+	// if the job with given ID still holds the task lease. Returns false if the lease is lost.
+	Requeue(ctx context.Context, id string, jobId string) (requeued bool, err error)
+	// Touch extends the lease of the task with given ID until the visibility timeout of its queue passes again,
+	// if the job with given ID still holds the task lease. Returns false if the lease is lost.
+	Touch(ctx context.Context, id string, jobId string) (touched bool, err error)
+	// Retry records a failed attempt of the task with given ID and puts the task back to its queue,
+	// to be delivered again at given run time, if the job with given ID still holds the task lease.
+	// Returns false if the lease is lost.
+	Retry(ctx context.Context, id string, jobId string, lastError string, runAt time.Time) (retried bool, err error)
+	// Fail records a failed attempt of the task with given ID and marks the task as failed,
+	// if the job with given ID still holds the task lease. Tasks of the workflow that depend on it are cancelled.
+	// Returns false if the lease is lost.
+	Fail(ctx context.Context, id string, jobId string, lastError string) (failed bool, err error)
+	// DeadLetter moves the task with given ID into the pending list of the dead-letter queue with given ID
+	// and adds given headers to it, if the job with given ID still holds the task lease.
+	// Attempts of the task are reset, so it follows the retry policy of the dead-letter queue,
+	// while its attempt history is kept.
+	// Tasks of the workflow that depend on it are cancelled. Returns false if the lease is lost.
+	DeadLetter(
+		ctx context.Context,
+		id string,
+		jobId string,
+		lastError string,
+		queueId string,
+		headers map[string]string,
+	) (moved bool, err error)
+	// RedeliverExpired puts the tasks of the queue with given ID whose leases expired before given time
+	// back to the pending list and increments their redelivery counters.
+	RedeliverExpired(ctx context.Context, queueId string, now time.Time) (count int, err error)
+	// ExpirePending marks pending tasks of the queue with given ID whose expiration time passed before given time
+	// as expired, so they are never delivered.
+	ExpirePending(ctx context.Context, queueId string, now time.Time) (count int, err error)
+	// Purge deletes the pending tasks of the queue with given ID, along with the delayed ones if requested.
+	// If original queue name is given, only the tasks dead-lettered from the queue with that name are deleted.
+	// Tasks leased to the jobs are never deleted. Returns the number of deleted tasks.
+	Purge(ctx context.Context, queueId string, delayed bool, originalQueue string) (count int, err error)
+	// PromoteDelayed atomically moves the delayed tasks of the queue with given ID that become eligible
+	// for delivery before given time to the pending list.
+	PromoteDelayed(ctx context.Context, queueId string, now time.Time) (count int, err error)
+	// CountExpired returns the number of tasks of the queue with given ID that expired before delivery.
+	CountExpired(ctx context.Context, queueId string) (count uint64, err error)
+	// Stats returns live statistics of the queue with given ID at given time.
+	Stats(ctx context.Context, queueId string, now time.Time) (stats *QueueStats, err error)
+	// Cancel atomically marks the task with given ID as cancelled, if it is still pending, delayed or waiting
+	// for its workflow parents. Tasks of the workflow that depend on it are cancelled as well.
+	// Returns false if the task is not pending, delayed or waiting anymore.
+	Cancel(ctx context.Context, id string) (cancelled bool, err error)
+	// FindByQueue returns a subset of the tasks of the queue with given ID, based on collection params given.
+	FindByQueue(ctx context.Context, queueId string, params *CollectionParams) (records []*Task, info *CollectionInfo, err error)
+}
+
+// NewTask creates a new instance of Task.
+// Task is delayed if it should not be delivered until given run time.
+func NewTask(
+	queueId string,
+	priority uint8,
+	headers map[string]string,
+	input []byte,
+	expiresAt time.Time,
+	runAt time.Time,
+) (task *Task) {
+
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+
+	now := time.Now()
+	status := TaskStatusPending
+	if runAt.After(now) {
+		status = TaskStatusDelayed
+	}
+
+	return &Task{
+		Id:        xid.New().String(),
+		QueueId:   queueId,
+		Status:    status,
+		Priority:  priority,
+		Headers:   headers,
+		Input:     input,
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+		RunAt:     runAt,
+	}
+}
+
 // TaskStatus represents a current state of the task.
 type TaskStatus uint8
 
 // Task represents a single unit of work that should be processed by worker(s).
 type Task struct {
-	Id         string            // unique ID
-	Status     TaskStatus        // processing status
-	Priority   uint8             // priority level
-	Headers    map[string]string // custom key->value pairs
-	Input      []byte            // payload data
-	CreatedAt  time.Time         // creation time
-	ExpiresAt  time.Time         // expiration time
-	FinishedAt time.Time         // processing finish time
+	Id             string            // unique ID
+	QueueId        string            // related queue ID
+	Status         TaskStatus        // processing status
+	Priority       uint8             // priority level
+	Headers        map[string]string // custom key->value pairs
+	Input          []byte            // payload data
+	Output         []byte            // result data, kept until the result TTL of the queue passes
+	CreatedAt      time.Time         // creation time
+	ExpiresAt      time.Time         // expiration time
+	RunAt          time.Time         // time the task becomes eligible for delivery
+	FinishedAt     time.Time         // processing finish time
+	JobId          string            // ID of the job holding the task lease
+	Redeliveries   uint32            // number of redeliveries caused by expired leases
+	Attempts       uint32            // number of failed processing attempts
+	LastError      string            // reason of the last failed processing attempt
+	WorkflowId     string            // related workflow ID, if the task is a part of the workflow
+	PendingParents uint32            // number of the workflow parents of the task that are not finished yet
+	BatchId        string            // related batch ID, if the task is a part of the batch
+	UniqueKey      string            // uniqueness key, held by at most one unfinished task of the queue
+}
+
+// PublishOptions holds optional parameters of the task publishing.
+type PublishOptions struct {
+	RunAt          time.Time // time the task becomes eligible for delivery, zero means right away
+	ExpiresAt      time.Time // expiration time, zero means never
+	IdempotencyKey string    // key that deduplicates publishing within the idempotency window of the queue
+	UniqueKey      string    // key that is held by at most one unfinished task of the queue
 }
@@ -1,5 +1,73 @@
 package models
 
+import (
+	"context"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+const (
+	WorkerStatusAlive WorkerStatus = iota
+	WorkerStatusDead
+)
+
+// WorkersRepository is an interface that all workers storage should implement.
+type WorkersRepository interface {
+	// Save persists given worker instance to the repo.
+	Save(ctx context.Context, record *Worker) (err error)
+	// GetById retrieves worker with given ID from the repo.
+	GetById(ctx context.Context, id string) (record *Worker, err error)
+	// MGetById retrieves workers with given IDs from the repo.
+	MGetById(ctx context.Context, ids []string) (records []*Worker, err error)
+	// Find returns a subset of the live workers, based on collection params given.
+	Find(ctx context.Context, params *CollectionParams) (records []*Worker, info *CollectionInfo, err error)
+	// FindStale returns up to limit live workers whose last heartbeat is not after given time.
+	FindStale(ctx context.Context, heartbeatBefore time.Time, limit int) (records []*Worker, err error)
+	// Heartbeat atomically sets last heartbeat time of the worker with given ID, if it is alive.
+	// Returns false if the worker does not exist or is dead.
+	Heartbeat(ctx context.Context, id string, heartbeatAt time.Time) (alive bool, err error)
+	// MarkDead atomically marks worker with given ID as dead, if it is alive and its last heartbeat is not after
+	// given time. Returns IDs of the jobs the worker was holding, so their leases can be released.
+	MarkDead(ctx context.Context, id string, heartbeatBefore time.Time) (jobIds []string, marked bool, err error)
+	// AddJob atomically adds job with given ID to the list of jobs the worker is holding, if the worker is alive.
+	// Returns false if the worker does not exist or is dead.
+	AddJob(ctx context.Context, id string, jobId string) (added bool, err error)
+	// RemoveJob removes job with given ID from the list of jobs the worker is holding.
+	RemoveJob(ctx context.Context, id string, jobId string) (err error)
+}
+
+// NewWorker creates a new instance of Worker.
+func NewWorker(name, hostname, version string, queues []string, concurrency uint32) (worker *Worker) {
+
+	now := time.Now()
+
+	return &Worker{
+		Id:          xid.New().String(),
+		Name:        name,
+		Hostname:    hostname,
+		Version:     version,
+		Queues:      queues,
+		Concurrency: concurrency,
+		Status:      WorkerStatusAlive,
+		CreatedAt:   now,
+		HeartbeatAt: now,
+	}
+}
+
+// WorkerStatus represents a current state of the worker.
+type WorkerStatus uint8
+
+// Worker represents a process that consumes jobs from the queues.
 type Worker struct {
-	Id string
+	Id          string       // unique ID
+	Name        string       // human-readable name
+	Hostname    string       // host the worker runs on
+	Version     string       // worker software version
+	Queues      []string     // names of the subscribed queues
+	Concurrency uint32       // max number of jobs processed at once
+	Status      WorkerStatus // liveness status
+	JobIds      []string     // IDs of the jobs the worker is holding
+	CreatedAt   time.Time    // registration time
+	HeartbeatAt time.Time    // last heartbeat time
 }
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/xid"
+)
+
+const (
+	WorkflowStatusRunning  WorkflowStatus = iota // some tasks are not finished yet
+	WorkflowStatusFinished                       // all tasks are finished successfully
+	WorkflowStatusFailed                         // some tasks failed, expired or were cancelled
+)
+
+// WorkflowsRepository is an interface that all workflows storage should implement.
+type WorkflowsRepository interface {
+	// Save persists given workflow instance along with its tasks to the repo in a single transaction.
+	// Tasks without parents are put into the pending lists of their queues, the rest are waiting
+	// until all their parents are finished.
+	Save(ctx context.Context, record *Workflow, tasks []*Task) (err error)
+	// GetById retrieves workflow with given ID from the repo.
+	GetById(ctx context.Context, id string) (record *Workflow, err error)
+	// MGetById retrieves workflows with given IDs from the repo.
+	MGetById(ctx context.Context, ids []string) (records []*Workflow, err error)
+	// Find returns a subset of the workflows, based on collection params given.
+	Find(ctx context.Context, params *CollectionParams) (records []*Workflow, info *CollectionInfo, err error)
+}
+
+// NewWorkflow creates a new instance of Workflow.
+func NewWorkflow(name string) (workflow *Workflow) {
+	return &Workflow{
+		Id:        xid.New().String(),
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+}
+
+// WorkflowStatus represents an overall state of the workflow.
+type WorkflowStatus uint8
+
+// Workflow represents a directed acyclic graph of tasks, where a task is delivered only after all its parents
+// are finished successfully. Once a task fails permanently, all tasks that depend on it are cancelled.
+type Workflow struct {
+	Id        string          // unique ID
+	Name      string          // custom name
+	Nodes     []*WorkflowNode // nodes of the graph, in the order of submission
+	Status    WorkflowStatus  // overall status, derived from the statuses of the tasks
+	CreatedAt time.Time       // creation time
+}
+
+// WorkflowNode represents a task of the workflow along with its dependencies.
+type WorkflowNode struct {
+	TaskId    string   // related task ID
+	Key       string   // key of the task, unique within the workflow
+	ParentIds []string // IDs of the tasks that must be finished before this task is delivered
+}
+
+// WorkflowTaskSpec describes a task of the workflow being submitted.
+type WorkflowTaskSpec struct {
+	Key       string            // key of the task, unique within the workflow
+	Queue     string            // name of the queue
+	Priority  uint8             // priority level
+	Headers   map[string]string // custom key->value pairs
+	Input     []byte            // payload data
+	DependsOn []string          // keys of the parent tasks
+}
+
+// AddTask adds the task with given key and parents to the workflow.
+// Task that has parents is waiting until all of them are finished.
+func (workflow *Workflow) AddTask(task *Task, key string, parentIds []string) {
+
+	task.WorkflowId = workflow.Id
+	if len(parentIds) > 0 {
+		task.Status = TaskStatusWaiting
+		task.PendingParents = uint32(len(parentIds))
+	}
+
+	workflow.Nodes = append(workflow.Nodes, &WorkflowNode{
+		TaskId:    task.Id,
+		Key:       key,
+		ParentIds: parentIds,
+	})
+}
@@ -0,0 +1,11 @@
+package daemons
+
+// Daemon is an interface that should be implemented by all background processes.
+type Daemon interface {
+	// Name returns a human-readable name of the daemon.
+	Name() (name string)
+	// Run executes daemon loop until Stop is called.
+	Run()
+	// Stop asks daemon to stop and waits until it is done.
+	Stop()
+}
@@ -1,8 +1,306 @@
 package daemons
 
+import (
+	"context"
+	"time"
+
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	schedulerSchedulesBatchSize int = 100 // max number of due schedules fetched at once
+	schedulerWorkersBatchSize   int = 100 // max number of stale workers fetched at once
+)
+
+// NewScheduler creates a new instance of Scheduler.
+func NewScheduler(
+	queuesRepo models.QueuesRepository,
+	tasksRepo models.TasksRepository,
+	jobsRepo models.JobsRepository,
+	schedulesRepo models.SchedulesRepository,
+	workersRepo models.WorkersRepository,
+	logger *zap.Logger,
+	interval time.Duration,
+	workerTimeout time.Duration,
+) (d *Scheduler) {
+	return &Scheduler{
+		queuesRepo:    queuesRepo,
+		tasksRepo:     tasksRepo,
+		jobsRepo:      jobsRepo,
+		schedulesRepo: schedulesRepo,
+		workersRepo:   workersRepo,
+		logger:        logger,
+		interval:      interval,
+		workerTimeout: workerTimeout,
+		stopChan:      make(chan struct{}),
+		doneChan:      make(chan struct{}),
+	}
+}
+
+// Scheduler daemon periodically performs time-based maintenance of all queues.
+// It is safe to run schedulers on several server nodes at once, as all operations are atomic.
 type Scheduler struct {
+	queuesRepo    models.QueuesRepository    // queues repository
+	tasksRepo     models.TasksRepository     // tasks repository
+	jobsRepo      models.JobsRepository      // jobs repository
+	schedulesRepo models.SchedulesRepository // schedules repository
+	workersRepo   models.WorkersRepository   // workers repository
+	logger        *zap.Logger                // logger instance
+	interval      time.Duration              // delay between maintenance iterations
+	workerTimeout time.Duration              // max delay between heartbeats of the live worker
+	stopChan      chan struct{}              // closed when daemon is asked to stop
+	doneChan      chan struct{}              // closed when daemon loop is finished
+}
+
+// Name returns a human-readable name of the daemon.
+func (d *Scheduler) Name() (name string) {
+	return "Scheduler"
 }
 
+// Run executes maintenance loop until Stop is called.
 func (d *Scheduler) Run() {
 
+	defer close(d.doneChan)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.stopChan:
+			return
+		case <-ticker.C:
+			err := d.tick(context.Background(), time.Now())
+			if err != nil {
+				d.logger.Error("Scheduler iteration failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Stop asks scheduler to stop and waits until the current iteration is finished.
+func (d *Scheduler) Stop() {
+	close(d.stopChan)
+	<-d.doneChan
+}
+
+// tick performs a single maintenance iteration over all known schedules, workers and queues.
+func (d *Scheduler) tick(ctx context.Context, now time.Time) (err error) {
+
+	// Materialize tasks of the recurring schedules
+	err = d.fireSchedules(ctx, now)
+	if err != nil {
+		d.logger.Error("Schedules firing failed", zap.Error(err))
+	}
+
+	// Release jobs of the workers that stopped sending heartbeats
+	err = d.reapWorkers(ctx, now)
+	if err != nil {
+		d.logger.Error("Workers reaping failed", zap.Error(err))
+	}
+
+	return d.eachQueue(ctx, func(queue *models.Queue) (err error) {
+
+		// Promote delayed tasks whose run time has come
+		count, err := d.tasksRepo.PromoteDelayed(ctx, queue.Id, now)
+		if err != nil {
+			return errors.Wrap(err, "repository PromoteDelayed failed")
+		}
+		if count > 0 {
+			d.logger.Info("Delayed tasks are promoted", zap.String("queue", queue.Name), zap.Int("count", count))
+		}
+
+		// Redeliver tasks of the crashed or stuck workers
+		count, err = d.tasksRepo.RedeliverExpired(ctx, queue.Id, now)
+		if err != nil {
+			return errors.Wrap(err, "repository RedeliverExpired failed")
+		}
+		if count > 0 {
+			d.logger.Info("Tasks with expired leases are redelivered", zap.String("queue", queue.Name), zap.Int("count", count))
+		}
+
+		// Expire pending tasks that are not relevant anymore
+		count, err = d.tasksRepo.ExpirePending(ctx, queue.Id, now)
+		if err != nil {
+			return errors.Wrap(err, "repository ExpirePending failed")
+		}
+		if count > 0 {
+			d.logger.Info("Pending tasks are expired", zap.String("queue", queue.Name), zap.Int("count", count))
+		}
+
+		return
+	})
+}
+
+// fireSchedules puts a task into the queue for every schedule whose next firing time passed before given time.
+// Firings missed during a downtime are caught up with a single task, the rest of them are skipped.
+// Tasks are enqueued before schedules are advanced and have deterministic IDs, so a firing is never lost
+// or duplicated, even if the server stops in between or several schedulers fire the same schedule at once.
+func (d *Scheduler) fireSchedules(ctx context.Context, now time.Time) (err error) {
+	for {
+
+		// Fetch next batch of the due schedules
+		records, err := d.schedulesRepo.FindDue(ctx, now, schedulerSchedulesBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "repository FindDue failed")
+		}
+
+		fired := 0
+		for _, record := range records {
+			if record == nil {
+				continue
+			}
+			err = d.fireSchedule(ctx, record, now)
+			if err != nil {
+				d.logger.Error("Schedule firing failed", zap.String("schedule", record.Id), zap.Error(err))
+				continue
+			}
+			fired++
+		}
+
+		// Stop if there are no more due schedules or the rest of them keep failing
+		if len(records) < schedulerSchedulesBatchSize || fired == 0 {
+			return nil
+		}
+	}
+}
+
+// fireSchedule puts a task of the schedule into the queue and moves the schedule to its next firing time
+// after given time, so firings that were missed in the meantime are skipped.
+func (d *Scheduler) fireSchedule(ctx context.Context, record *models.Schedule, now time.Time) (err error) {
+
+	cron, err := models.ParseCron(record.Expression)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse expression")
+	}
+
+	next := cron.Next(record.NextRunAt)
+	if !next.IsZero() && !next.After(now) {
+		d.logger.Info("Missed schedule firings are skipped", zap.String("schedule", record.Id), zap.Time("firedAt", record.NextRunAt))
+		next = cron.Next(now)
+	}
+	if next.IsZero() {
+		return errors.New("expression never fires again")
+	}
+
+	// Enqueue task of the current firing
+	enqueued, err := d.tasksRepo.Enqueue(ctx, models.NewScheduledTask(record, record.NextRunAt))
+	if err != nil {
+		return errors.Wrap(err, "repository Enqueue failed")
+	}
+	if enqueued {
+		d.logger.Info("Scheduled task is enqueued", zap.String("schedule", record.Id), zap.Time("firedAt", record.NextRunAt))
+	}
+
+	// Move to the next firing
+	_, err = d.schedulesRepo.Advance(ctx, record.Id, record.NextRunAt, next)
+	if err != nil {
+		return errors.Wrap(err, "repository Advance failed")
+	}
+
+	return
+}
+
+// reapWorkers marks workers whose last heartbeat is older than the worker timeout as dead
+// and puts tasks of the jobs they were holding back to the queues.
+func (d *Scheduler) reapWorkers(ctx context.Context, now time.Time) (err error) {
+
+	deadline := now.Add(-d.workerTimeout)
+	for {
+
+		// Fetch next batch of the stale workers
+		records, err := d.workersRepo.FindStale(ctx, deadline, schedulerWorkersBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "repository FindStale failed")
+		}
+
+		// Every worker that is handled leaves the index, as the index entries of the workers that are missing
+		// or not alive anymore are dropped by the repository
+		handled := 0
+		for _, record := range records {
+			if record == nil {
+				handled++
+				continue
+			}
+			err = d.reapWorker(ctx, record, deadline, now)
+			if err != nil {
+				d.logger.Error("Worker reaping failed", zap.String("worker", record.Id), zap.Error(err))
+				continue
+			}
+			handled++
+		}
+
+		// Stop if there are no more stale workers or the rest of them keep failing
+		if len(records) < schedulerWorkersBatchSize || handled == 0 {
+			return nil
+		}
+	}
+}
+
+// reapWorker marks the worker as dead, unless it sent a heartbeat in the meantime, and releases its jobs.
+func (d *Scheduler) reapWorker(ctx context.Context, record *models.Worker, deadline, now time.Time) (err error) {
+
+	jobIds, marked, err := d.workersRepo.MarkDead(ctx, record.Id, deadline)
+	if err != nil {
+		return errors.Wrap(err, "repository MarkDead failed")
+	}
+	if !marked {
+		return
+	}
+	d.logger.Info("Worker is marked as dead", zap.String("worker", record.Id), zap.String("name", record.Name))
+
+	for _, jobId := range jobIds {
+
+		job, err := d.jobsRepo.GetById(ctx, jobId)
+		if err != nil {
+			return errors.Wrap(err, "repository GetById failed")
+		}
+		if job == nil {
+			continue
+		}
+
+		// Put task back to the queue, unless the lease is already gone
+		_, err = d.tasksRepo.Requeue(ctx, job.TaskId, job.Id)
+		if err != nil {
+			return errors.Wrap(err, "repository Requeue failed")
+		}
+		_, err = d.jobsRepo.Finish(ctx, job.Id, now)
+		if err != nil {
+			return errors.Wrap(err, "repository Finish failed")
+		}
+	}
+
+	return
+}
+
+// eachQueue is a helper function that calls given function for every known queue.
+// Failure for one queue is logged and does not prevent the rest of the queues from being processed.
+func (d *Scheduler) eachQueue(ctx context.Context, fn func(queue *models.Queue) (err error)) (err error) {
+
+	params := models.NewCollectionParams("0", 0)
+	for {
+
+		// Fetch next page of the queues
+		records, info, err := d.queuesRepo.Find(ctx, params)
+		if err != nil {
+			return errors.Wrap(err, "repository Find failed")
+		}
+
+		for _, record := range records {
+			if record == nil {
+				continue
+			}
+			err = fn(record)
+			if err != nil {
+				d.logger.Error("Queue maintenance failed", zap.String("queue", record.Name), zap.Error(err))
+			}
+		}
+
+		if info.Cursor == "0" {
+			return nil
+		}
+		params.Cursor = info.Cursor
+	}
 }
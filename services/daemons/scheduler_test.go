@@ -0,0 +1,149 @@
+package daemons
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	"github.com/gork-io/gork/services/resources"
+	redis_repo "github.com/gork-io/gork/transformers/repositories/redis"
+	"go.uber.org/zap"
+)
+
+// testSchedulerEnv holds the scheduler along with the repositories it is backed by.
+type testSchedulerEnv struct {
+	server      *miniredis.Miniredis
+	scheduler   *Scheduler
+	queuesRepo  *redis_repo.QueuesRepository
+	tasksRepo   *redis_repo.TasksRepository
+	jobsRepo    *redis_repo.JobsRepository
+	workersRepo *redis_repo.WorkersRepository
+}
+
+// newTestSchedulerEnv creates scheduler backed by in-memory redis server, with 30 seconds worker timeout.
+func newTestSchedulerEnv(t *testing.T) (env *testSchedulerEnv) {
+
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start redis server: %v", err)
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	env = &testSchedulerEnv{
+		server:      server,
+		queuesRepo:  redis_repo.NewQueuesRepository(client),
+		tasksRepo:   redis_repo.NewTasksRepository(client),
+		jobsRepo:    redis_repo.NewJobsRepository(client),
+		workersRepo: redis_repo.NewWorkersRepository(client),
+	}
+	env.scheduler = NewScheduler(
+		env.queuesRepo,
+		env.tasksRepo,
+		env.jobsRepo,
+		redis_repo.NewSchedulesRepository(client),
+		env.workersRepo,
+		zap.NewNop(),
+		time.Second,
+		30*time.Second,
+	)
+
+	return
+}
+
+// saveWorker saves worker with given last heartbeat time.
+func (env *testSchedulerEnv) saveWorker(t *testing.T, heartbeatAt time.Time) (worker *models.Worker) {
+
+	worker = models.NewWorker("worker", "host", "1.0", []string{"q"}, 1)
+	worker.HeartbeatAt = heartbeatAt
+	if err := env.workersRepo.Save(context.Background(), worker); err != nil {
+		t.Fatalf("failed to save worker: %v", err)
+	}
+	return
+}
+
+func TestSchedulerReapWorkers(t *testing.T) {
+
+	ctx := context.Background()
+	env := newTestSchedulerEnv(t)
+	defer env.server.Close()
+	jobsSvc := resources.NewJobs(env.jobsRepo, env.tasksRepo, env.queuesRepo, env.workersRepo)
+
+	queue := models.NewQueue("q", nil)
+	if err := env.queuesRepo.Save(ctx, queue); err != nil {
+		t.Fatalf("failed to save queue: %v", err)
+	}
+	task := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, time.Time{})
+	if _, err := env.tasksRepo.Enqueue(ctx, task); err != nil {
+		t.Fatalf("failed to enqueue task: %v", err)
+	}
+	stale := env.saveWorker(t, time.Now().Add(-time.Minute))
+	alive := env.saveWorker(t, time.Now())
+	job, err := jobsSvc.Next(ctx, []string{queue.Id}, stale.Id)
+	if err != nil || job == nil {
+		t.Fatalf("Next() = %+v, %v, want job", job, err)
+	}
+
+	if err := env.scheduler.reapWorkers(ctx, time.Now()); err != nil {
+		t.Fatalf("reapWorkers() failed: %v", err)
+	}
+
+	// Stale worker is dead and its job is released
+	if worker, err := env.workersRepo.GetById(ctx, stale.Id); err != nil || worker.Status != models.WorkerStatusDead || len(worker.JobIds) != 0 {
+		t.Fatalf("stale worker = %+v, %v, want dead worker without jobs", worker, err)
+	}
+	if worker, err := env.workersRepo.GetById(ctx, alive.Id); err != nil || worker.Status != models.WorkerStatusAlive {
+		t.Fatalf("live worker = %+v, %v, want alive", worker, err)
+	}
+	if record, err := env.jobsRepo.GetById(ctx, job.Id); err != nil || record.FinishedAt.IsZero() {
+		t.Fatalf("job of stale worker = %+v, %v, want finished", record, err)
+	}
+	if record, err := env.tasksRepo.GetById(ctx, task.Id); err != nil || record.Status != models.TaskStatusPending {
+		t.Fatalf("task of stale worker = %+v, %v, want pending", record, err)
+	}
+
+	// Dead worker can neither come back nor take new jobs
+	if ok, err := env.workersRepo.Heartbeat(ctx, stale.Id, time.Now()); ok || err != nil {
+		t.Fatalf("Heartbeat() of dead worker = %v, %v, want false", ok, err)
+	}
+	if job, err := jobsSvc.Next(ctx, []string{queue.Id}, stale.Id); job != nil || err == nil {
+		t.Fatalf("Next() for dead worker = %+v, %v, want error", job, err)
+	}
+	if record, err := env.tasksRepo.GetById(ctx, task.Id); err != nil || record.Status != models.TaskStatusPending {
+		t.Fatalf("task refused by dead worker = %+v, %v, want pending", record, err)
+	}
+}
+
+func TestSchedulerReapWorkersMissing(t *testing.T) {
+
+	ctx := context.Background()
+	env := newTestSchedulerEnv(t)
+	defer env.server.Close()
+
+	// More than one batch of stale workers, half of them are gone from the repo but still indexed
+	for i := 0; i < 3*schedulerWorkersBatchSize/2; i++ {
+		worker := env.saveWorker(t, time.Now().Add(-time.Hour))
+		if i%2 == 0 {
+			env.server.Del("workers:" + worker.Id)
+		}
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- env.scheduler.reapWorkers(ctx, time.Now())
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("reapWorkers() failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("reapWorkers() did not terminate")
+	}
+
+	if records, err := env.workersRepo.FindStale(ctx, time.Now(), schedulerWorkersBatchSize); len(records) != 0 || err != nil {
+		t.Fatalf("FindStale() after reaping = %v, %v, want none", records, err)
+	}
+}
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+// NewBatches creates a new instance of Batches.
+func NewBatches(batchesRepo models.BatchesRepository, queuesRepo models.QueuesRepository) (res *Batches) {
+	return &Batches{
+		batchesRepo: batchesRepo,
+		queuesRepo:  queuesRepo,
+	}
+}
+
+// Batches resource service implements operations that are related to the groups of tasks with completion callbacks.
+type Batches struct {
+	batchesRepo models.BatchesRepository // batches repository
+	queuesRepo  models.QueuesRepository  // queues repository
+}
+
+// List returns a subset of the batches, based on collection params given.
+func (res *Batches) List(
+	ctx context.Context,
+	params *models.CollectionParams,
+) (records []*models.Batch, info *models.CollectionInfo, err error) {
+
+	// Retrieve collection from the repo
+	records, info, err = res.batchesRepo.Find(ctx, params)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository Find failed")
+	}
+
+	return
+}
+
+// Create submits a new batch of given tasks. Once every task of the batch is finished, failed or cancelled,
+// the callback task is put into its queue exactly once, with the summary of the batch as its input.
+// Returns tasks in the order of the specs given.
+func (res *Batches) Create(
+	ctx context.Context,
+	name string,
+	specs []*models.BatchTaskSpec,
+	callbackSpec *models.BatchTaskSpec,
+	includeResults bool,
+) (record *models.Batch, tasks []*models.Task, err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"name":     validation.Validate(name, validation.Length(0, 255)),
+		"tasks":    validation.Validate(specs, validation.Required, validation.Length(1, 10000)),
+		"callback": validateBatchTaskSpec(callbackSpec),
+	}
+	for i, spec := range specs {
+		vErr["tasks["+strconv.Itoa(i)+"]"] = validateBatchTaskSpec(spec)
+	}
+	if err = vErr.Filter(); err != nil {
+		return nil, nil, errors.Wrap(err, "validation error")
+	}
+
+	// Create tasks, resolving every queue once
+	queues := make(map[string]*models.Queue)
+	newTask := func(spec *models.BatchTaskSpec) (task *models.Task, err error) {
+		queue, ok := queues[spec.Queue]
+		if !ok {
+			queue, err = res.queuesRepo.GetByName(ctx, spec.Queue)
+			if err != nil {
+				return nil, errors.Wrap(err, "repository GetByName failed")
+			}
+			if queue == nil {
+				return nil, errors.Errorf("queue %q does not exist", spec.Queue)
+			}
+			queues[spec.Queue] = queue
+		}
+		return models.NewTask(queue.Id, spec.Priority, spec.Headers, spec.Input, time.Time{}, time.Time{}), nil
+	}
+	record = models.NewBatch(name, includeResults)
+	for _, spec := range specs {
+		task, err := newTask(spec)
+		if err != nil {
+			return nil, nil, err
+		}
+		record.AddTask(task)
+		tasks = append(tasks, task)
+	}
+	callback, err := newTask(callbackSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+	record.SetCallback(callback)
+
+	// Save records to the repo
+	err = res.batchesRepo.Save(ctx, record, tasks, callback)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository Save failed")
+	}
+
+	return
+}
+
+// Read returns batch by its ID, including the progress counters.
+func (res *Batches) Read(ctx context.Context, id string) (record *models.Batch, err error) {
+
+	// Retrieve record from the repo
+	record, err = res.batchesRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+
+	return
+}
+
+// validateBatchTaskSpec checks that batch task spec is valid.
+func validateBatchTaskSpec(spec *models.BatchTaskSpec) (err error) {
+
+	if spec == nil {
+		return errors.New("must not be empty")
+	}
+	vErr := validation.Errors{
+		"queue": validation.Validate(spec.Queue, validation.Required, validation.Length(1, 255)),
+	}
+	for key := range spec.Headers {
+		vErr["headers["+key+"]"] = validateTaskHeaderKey(key)
+	}
+
+	return vErr.Filter()
+}
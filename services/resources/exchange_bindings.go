@@ -0,0 +1,139 @@
+package resources
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+// NewExchangeBindings creates a new instance of ExchangeBindings.
+func NewExchangeBindings(
+	bindingsRepo models.ExchangeBindingsRepository,
+	exchangesRepo models.ExchangesRepository,
+	queuesRepo models.QueuesRepository,
+) (res *ExchangeBindings) {
+	return &ExchangeBindings{
+		bindingsRepo:  bindingsRepo,
+		exchangesRepo: exchangesRepo,
+		queuesRepo:    queuesRepo,
+	}
+}
+
+// ExchangeBindings resource service implements operations that are related to the routing of the tasks
+// from the exchanges to the queues.
+type ExchangeBindings struct {
+	bindingsRepo  models.ExchangeBindingsRepository // exchange bindings repository
+	exchangesRepo models.ExchangesRepository        // exchanges repository
+	queuesRepo    models.QueuesRepository           // queues repository
+}
+
+// List returns all bindings of the exchange with given ID.
+func (res *ExchangeBindings) List(ctx context.Context, exchangeId string) (records []*models.ExchangeBinding, err error) {
+
+	// Retrieve collection from the repo
+	records, err = res.bindingsRepo.FindByExchange(ctx, exchangeId)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository FindByExchange failed")
+	}
+
+	return
+}
+
+// Create binds the queue with given name to the exchange with given name.
+// Fanout exchange routes every task to the queue, direct exchange routes a task if its routing key equals
+// the binding key, topic exchange routes a task if its routing key matches the binding pattern,
+// and headers exchange routes a task if its headers match all the rules.
+func (res *ExchangeBindings) Create(
+	ctx context.Context,
+	exchangeName string,
+	queueName string,
+	routingKey string,
+	rules []*models.ExchangeBindingRule,
+) (record *models.ExchangeBinding, err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"exchange":   validateExchangeName(exchangeName),
+		"queue":      validateQueueName(queueName),
+		"routingKey": validateExchangeRoutingKey(routingKey),
+		"rules":      validation.Validate(rules, validation.Length(0, 100)),
+	}
+	for i, rule := range rules {
+		vErr["rules["+strconv.Itoa(i)+"]"] = validateExchangeBindingRule(rule)
+	}
+	if err = vErr.Filter(); err != nil {
+		return nil, errors.Wrap(err, "validation error")
+	}
+	exchange, err := res.exchangesRepo.GetByName(ctx, exchangeName)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetByName failed")
+	}
+	if exchange == nil {
+		return nil, errors.New("exchange with such name does not exist")
+	}
+	switch exchange.Type {
+	case models.ExchangeTypeDirect, models.ExchangeTypeTopic:
+		if len(rules) > 0 {
+			return nil, errors.New("rules are supported by headers exchanges only")
+		}
+	case models.ExchangeTypeFanout:
+		if len(rules) > 0 || routingKey != "" {
+			return nil, errors.New("fanout exchange does not support routing keys and rules")
+		}
+	default:
+		if routingKey != "" {
+			return nil, errors.New("routing keys are supported by direct and topic exchanges only")
+		}
+	}
+	queue, err := res.queuesRepo.GetByName(ctx, queueName)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetByName failed")
+	}
+	if queue == nil {
+		return nil, errors.New("queue with such name does not exist")
+	}
+
+	// Save record to the repo
+	record = models.NewExchangeBinding(exchange.Id, queue.Id, routingKey, rules)
+	err = res.bindingsRepo.Save(ctx, record)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository Save failed")
+	}
+
+	return
+}
+
+// Read returns binding by its ID.
+func (res *ExchangeBindings) Read(ctx context.Context, id string) (record *models.ExchangeBinding, err error) {
+
+	// Retrieve record from the repo
+	record, err = res.bindingsRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+
+	return
+}
+
+// Delete removes binding with given ID from the repository.
+func (res *ExchangeBindings) Delete(ctx context.Context, id string) (err error) {
+	return errors.Wrap(res.bindingsRepo.Delete(ctx, id), "repository Delete failed")
+}
+
+// validateExchangeBindingRule checks that exchange binding rule is valid.
+func validateExchangeBindingRule(rule *models.ExchangeBindingRule) (err error) {
+
+	if rule == nil {
+		return errors.New("must not be empty")
+	}
+	err = validation.Validate(rule.Key, validation.Required, validation.Length(1, 255))
+	if err != nil {
+		return errors.Wrap(err, "key")
+	}
+	_, err = rule.Compile()
+
+	return
+}
@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gork-io/gork/models"
+)
+
+const (
+	exchangeRoutersIdleTimeout = 10 * time.Minute // how long unused routers are kept in the cache
+)
+
+// NewExchangeRouters creates a new instance of ExchangeRouters.
+func NewExchangeRouters() (cache *ExchangeRouters) {
+	return &ExchangeRouters{
+		entries: make(map[string]*exchangeRoutersEntry),
+	}
+}
+
+// ExchangeRouters is a cache of the compiled exchange routers, so bindings are not fetched and compiled
+// for every published task. Cached router is used while the bindings version of the exchange stays the same.
+// Cache is shared by the services, so the router is dropped once its exchange is deleted. Routers of the
+// exchanges deleted on other server nodes are dropped once they are not used for a while.
+type ExchangeRouters struct {
+	mutex   sync.Mutex                       // guards entries
+	entries map[string]*exchangeRoutersEntry // exchange ID => cached router
+}
+
+// exchangeRoutersEntry represents a cached router.
+type exchangeRoutersEntry struct {
+	version int64                  // bindings version the router is compiled for
+	router  *models.ExchangeRouter // compiled router
+	usedAt  time.Time              // last time the router was used
+}
+
+// get returns cached router of the exchange with given ID, if it is compiled for given bindings version.
+// Router compiled for another version is dropped.
+func (cache *ExchangeRouters) get(exchangeId string, version int64) (router *models.ExchangeRouter) {
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	entry, ok := cache.entries[exchangeId]
+	if !ok {
+		return nil
+	}
+	if entry.version != version {
+		delete(cache.entries, exchangeId)
+		return nil
+	}
+	entry.usedAt = time.Now()
+
+	return entry.router
+}
+
+// put caches router of the exchange with given ID compiled for given bindings version,
+// replacing the router compiled for another version. Routers that are not used for a while are dropped.
+func (cache *ExchangeRouters) put(exchangeId string, version int64, router *models.ExchangeRouter) {
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	now := time.Now()
+	for id, entry := range cache.entries {
+		if now.Sub(entry.usedAt) > exchangeRoutersIdleTimeout {
+			delete(cache.entries, id)
+		}
+	}
+
+	cache.entries[exchangeId] = &exchangeRoutersEntry{
+		version: version,
+		router:  router,
+		usedAt:  now,
+	}
+}
+
+// drop removes cached router of the exchange with given ID.
+func (cache *ExchangeRouters) drop(exchangeId string) {
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	delete(cache.entries, exchangeId)
+}
@@ -0,0 +1,126 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+// NewExchanges creates a new instance of Exchanges.
+func NewExchanges(
+	exchangesRepo models.ExchangesRepository,
+	bindingsRepo models.ExchangeBindingsRepository,
+	routers *ExchangeRouters,
+) (res *Exchanges) {
+	return &Exchanges{
+		exchangesRepo: exchangesRepo,
+		bindingsRepo:  bindingsRepo,
+		routers:       routers,
+	}
+}
+
+// Exchanges resource service implements operations that are related to the exchanges management.
+type Exchanges struct {
+	exchangesRepo models.ExchangesRepository        // exchanges repository
+	bindingsRepo  models.ExchangeBindingsRepository // exchange bindings repository
+	routers       *ExchangeRouters                  // compiled exchange routers
+}
+
+// List returns a subset of the exchanges, based on collection params given.
+func (res *Exchanges) List(
+	ctx context.Context,
+	params *models.CollectionParams,
+) (records []*models.Exchange, info *models.CollectionInfo, err error) {
+
+	// Retrieve collection from the repo
+	records, info, err = res.exchangesRepo.Find(ctx, params)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository Find failed")
+	}
+
+	return
+}
+
+// Create creates a new exchange instance of given type and saves it to the repository.
+func (res *Exchanges) Create(
+	ctx context.Context,
+	name string,
+	exchangeType models.ExchangeType,
+) (record *models.Exchange, err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"name": validateExchangeName(name),
+		"type": validation.Validate(int(exchangeType), validation.Max(int(models.ExchangeTypeTopic))),
+	}
+	if err = vErr.Filter(); err != nil {
+		return nil, errors.Wrap(err, "validation error")
+	}
+	existing, err := res.exchangesRepo.GetByName(ctx, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetByName failed")
+	}
+	if existing != nil {
+		return nil, errors.New("exchange with such name already exists")
+	}
+
+	// Save record to the repo
+	record = models.NewExchange(name, exchangeType)
+	err = res.exchangesRepo.Save(ctx, record)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository Save failed")
+	}
+
+	return
+}
+
+// Read returns exchange by its ID.
+func (res *Exchanges) Read(ctx context.Context, id string) (record *models.Exchange, err error) {
+
+	// Retrieve record from the repo
+	record, err = res.exchangesRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+
+	return
+}
+
+// Delete removes exchange with given ID and all its bindings from the repository.
+func (res *Exchanges) Delete(ctx context.Context, id string) (err error) {
+
+	// Remove bindings
+	bindings, err := res.bindingsRepo.FindByExchange(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "repository FindByExchange failed")
+	}
+	for _, binding := range bindings {
+		err = res.bindingsRepo.Delete(ctx, binding.Id)
+		if err != nil {
+			return errors.Wrap(err, "repository Delete failed")
+		}
+	}
+
+	// Remove exchange
+	err = res.exchangesRepo.Delete(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "repository Delete failed")
+	}
+
+	// Drop compiled router
+	res.routers.drop(id)
+
+	return
+}
+
+// validateExchangeName checks that exchange name is valid.
+func validateExchangeName(name string) (err error) {
+	return validation.Validate(name, validation.Required, validation.Length(1, 255))
+}
+
+// validateExchangeRoutingKey checks that routing key of the task or binding key of the binding is valid.
+func validateExchangeRoutingKey(routingKey string) (err error) {
+	return validation.Validate(routingKey, validation.Length(0, 255))
+}
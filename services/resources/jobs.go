@@ -0,0 +1,375 @@
+package resources
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+// NewJobs creates a new instance of Jobs.
+func NewJobs(
+	jobsRepo models.JobsRepository,
+	tasksRepo models.TasksRepository,
+	queuesRepo models.QueuesRepository,
+	workersRepo models.WorkersRepository,
+) (res *Jobs) {
+	return &Jobs{
+		jobsRepo:    jobsRepo,
+		tasksRepo:   tasksRepo,
+		queuesRepo:  queuesRepo,
+		workersRepo: workersRepo,
+	}
+}
+
+// Jobs resource service implements operations that are related to the delivery of the jobs to the workers.
+type Jobs struct {
+	jobsRepo    models.JobsRepository    // jobs repository
+	tasksRepo   models.TasksRepository   // tasks repository
+	queuesRepo  models.QueuesRepository  // queues repository
+	workersRepo models.WorkersRepository // workers repository
+}
+
+// Subscribe resolves names of the queues the worker wants to consume from into queue IDs.
+// If worker ID is given, the worker must be registered and alive.
+func (res *Jobs) Subscribe(ctx context.Context, queueNames []string, workerId string) (queueIds []string, err error) {
+
+	// Validate input
+	err = validation.Validate(queueNames, validation.Required)
+	if err != nil {
+		return nil, errors.Wrap(err, "validation error")
+	}
+	if workerId != "" {
+		worker, err := res.workersRepo.GetById(ctx, workerId)
+		if err != nil {
+			return nil, errors.Wrap(err, "repository GetById failed")
+		}
+		if worker == nil || worker.Status != models.WorkerStatusAlive {
+			return nil, errors.New("worker does not exist or is dead")
+		}
+	}
+
+	// Resolve queues
+	for _, name := range queueNames {
+		queue, err := res.queuesRepo.GetByName(ctx, name)
+		if err != nil {
+			return nil, errors.Wrap(err, "repository GetByName failed")
+		}
+		if queue == nil {
+			return nil, errors.Errorf("queue %q does not exist", name)
+		}
+		queueIds = append(queueIds, queue.Id)
+	}
+
+	return
+}
+
+// Next takes the next pending task from the first non-empty queue of the given ones and turns it into a job.
+// If worker ID is given, the job is held by the worker until it is finished. Returns nil if all queues are empty.
+func (res *Jobs) Next(ctx context.Context, queueIds []string, workerId string) (record *models.Job, err error) {
+
+	for _, queueId := range queueIds {
+
+		// Take task from the queue
+		task, err := res.tasksRepo.Dequeue(ctx, queueId)
+		if err != nil {
+			return nil, errors.Wrap(err, "repository Dequeue failed")
+		}
+		if task == nil {
+			continue
+		}
+
+		// Save job to the repo
+		record = models.NewJob(task)
+		record.WorkerId = workerId
+		err = res.jobsRepo.Save(ctx, record)
+		if err != nil {
+			return nil, errors.Wrap(err, "repository Save failed")
+		}
+
+		// Hand job over to the worker, unless the worker is already marked as dead
+		if workerId != "" {
+			added, err := res.workersRepo.AddJob(ctx, workerId, record.Id)
+			if err != nil {
+				return nil, errors.Wrap(err, "repository AddJob failed")
+			}
+			if !added {
+				err = res.Release(ctx, record.Id)
+				if err != nil {
+					return nil, errors.Wrap(err, "release failed")
+				}
+				return nil, errors.New("worker does not exist or is dead")
+			}
+		}
+
+		return record, nil
+	}
+
+	return
+}
+
+// Ack marks job with given ID and its task as successfully finished and attaches output to the task.
+func (res *Jobs) Ack(ctx context.Context, id string, output []byte) (err error) {
+	return res.settle(ctx, id, func(job *models.Job) (bool, error) {
+		return res.tasksRepo.Finish(ctx, job.TaskId, job.Id, models.TaskStatusFinished, output)
+	})
+}
+
+// Nack marks job with given ID as finished and retries its task according to the retry policy of the queue.
+// Once the task runs out of attempts, it is moved to the dead-letter queue or marked as failed.
+func (res *Jobs) Nack(ctx context.Context, id string, lastError string) (err error) {
+	return res.settle(ctx, id, func(job *models.Job) (bool, error) {
+
+		// Retrieve task and queue
+		task, queue, err := res.fetchJobTask(ctx, job)
+		if err != nil || task == nil {
+			return false, err
+		}
+
+		// Apply retry policy, attempts are not changed concurrently as long as the job holds the lease
+		policy := models.NewRetryPolicy(queue.Settings)
+		attempts := task.Attempts + 1
+		if policy.Exhausted(attempts) {
+			return res.fail(ctx, job, task, queue, lastError, models.DeadLetterReasonExhausted)
+		}
+		runAt := time.Now().Add(policy.Backoff(attempts, rand.Float64()))
+
+		return res.tasksRepo.Retry(ctx, task.Id, job.Id, lastError, runAt)
+	})
+}
+
+// Reject marks job with given ID as finished and moves its task to the dead-letter queue or marks it as failed,
+// so it is never delivered to the same queue again.
+func (res *Jobs) Reject(ctx context.Context, id string, lastError string) (err error) {
+	return res.settle(ctx, id, func(job *models.Job) (bool, error) {
+
+		// Retrieve task and queue
+		task, queue, err := res.fetchJobTask(ctx, job)
+		if err != nil || task == nil {
+			return false, err
+		}
+
+		return res.fail(ctx, job, task, queue, lastError, models.DeadLetterReasonRejected)
+	})
+}
+
+// Release marks job with given ID as finished and puts its task back to the queue for another delivery,
+// without counting it as a failed attempt. It is used when the worker is gone before finishing the job.
+func (res *Jobs) Release(ctx context.Context, id string) (err error) {
+	return res.settle(ctx, id, func(job *models.Job) (bool, error) {
+		return res.tasksRepo.Requeue(ctx, job.TaskId, job.Id)
+	})
+}
+
+// Progress sets progress percentage of the job with given ID and extends the lease of its task.
+func (res *Jobs) Progress(ctx context.Context, id string, progress uint8) (err error) {
+
+	// Validate input
+	err = validation.Validate(int(progress), validation.Max(100))
+	if err != nil {
+		return errors.Wrap(err, "validation error")
+	}
+
+	// Update record in the repo
+	updated, err := res.jobsRepo.UpdateProgress(ctx, id, progress)
+	if err != nil {
+		return errors.Wrap(err, "repository UpdateProgress failed")
+	}
+	if !updated {
+		return errors.New("job does not exist or is already finished")
+	}
+
+	// Reported progress proves the job is alive, so its lease is extended
+	err = res.Touch(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "touch failed")
+	}
+
+	return
+}
+
+// Touch extends the lease of the task of the job with given ID until the visibility timeout of the queue
+// passes again, so the task is not redelivered while the job is still being processed.
+func (res *Jobs) Touch(ctx context.Context, id string) (err error) {
+
+	// Retrieve record from the repo
+	record, err := res.jobsRepo.GetById(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "repository GetById failed")
+	}
+	if record == nil || !record.FinishedAt.IsZero() {
+		return errors.New("job does not exist or is already finished")
+	}
+
+	// Extend lease
+	touched, err := res.tasksRepo.Touch(ctx, record.TaskId, record.Id)
+	if err != nil {
+		return errors.Wrap(err, "repository Touch failed")
+	}
+	if !touched {
+		return errors.New("job does not hold the task lease anymore")
+	}
+
+	return
+}
+
+// Log appends lines to the log of the job with given ID.
+func (res *Jobs) Log(ctx context.Context, id string, lines []string) (err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"lines": validation.Validate(lines, validation.Required, validation.Length(1, 100)),
+	}
+	for i, line := range lines {
+		vErr["lines["+strconv.Itoa(i)+"]"] = validateJobLogLine(line)
+	}
+	if err = vErr.Filter(); err != nil {
+		return errors.Wrap(err, "validation error")
+	}
+
+	// Update record in the repo
+	appended, err := res.jobsRepo.AppendLogs(ctx, id, lines)
+	if err != nil {
+		return errors.Wrap(err, "repository AppendLogs failed")
+	}
+	if !appended {
+		return errors.New("job does not exist or is already finished")
+	}
+
+	return
+}
+
+// Read returns job by its ID.
+func (res *Jobs) Read(ctx context.Context, id string) (record *models.Job, err error) {
+
+	// Retrieve record from the repo
+	record, err = res.jobsRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+
+	return
+}
+
+// Tail returns job by its ID along with its log lines, starting from given offset.
+// Job is read first, so no lines appended before it was finished are missed.
+func (res *Jobs) Tail(ctx context.Context, id string, offset int) (record *models.Job, lines []string, err error) {
+
+	// Retrieve record from the repo
+	record, err = res.jobsRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository GetById failed")
+	}
+	if record == nil {
+		return nil, nil, errors.New("job does not exist")
+	}
+
+	// Retrieve log lines from the repo
+	lines, err = res.jobsRepo.GetLogs(ctx, id, offset)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository GetLogs failed")
+	}
+
+	return
+}
+
+// fetchJobTask is a helper function that retrieves the task and the queue of the job given.
+// Returns nil task if it does not exist. Queue that does not exist anymore is replaced with a placeholder
+// having default settings.
+func (res *Jobs) fetchJobTask(ctx context.Context, job *models.Job) (task *models.Task, queue *models.Queue, err error) {
+
+	task, err = res.tasksRepo.GetById(ctx, job.TaskId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository GetById failed")
+	}
+	queue, err = res.queuesRepo.GetById(ctx, job.QueueId)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository GetById failed")
+	}
+	if queue == nil {
+		queue = &models.Queue{Id: job.QueueId, Settings: models.DefaultQueueSettings()}
+	}
+
+	return
+}
+
+// fail is a helper function that moves the task of the job into the dead-letter queue, if the queue has one,
+// or marks it as failed otherwise.
+func (res *Jobs) fail(
+	ctx context.Context,
+	job *models.Job,
+	task *models.Task,
+	queue *models.Queue,
+	lastError string,
+	reason string,
+) (applied bool, err error) {
+
+	// Resolve dead-letter queue
+	var deadLetterQueue *models.Queue
+	if name := queue.Settings[models.QueueSettingDeadLetterQueue]; name != "" && name != queue.Name {
+		deadLetterQueue, err = res.queuesRepo.GetByName(ctx, name)
+		if err != nil {
+			return false, errors.Wrap(err, "repository GetByName failed")
+		}
+	}
+	if deadLetterQueue == nil {
+		return res.tasksRepo.Fail(ctx, task.Id, job.Id, lastError)
+	}
+
+	// Move task
+	headers := map[string]string{
+		models.TaskHeaderDeadLetterReason: reason,
+		models.TaskHeaderDeadLetterError:  lastError,
+		models.TaskHeaderDeadLetteredAt:   time.Now().Format(time.RFC3339Nano),
+		models.TaskHeaderOriginalQueue:    queue.Name,
+		models.TaskHeaderAttempts:         strconv.FormatUint(uint64(task.Attempts+1), 10),
+	}
+
+	return res.tasksRepo.DeadLetter(ctx, task.Id, job.Id, lastError, deadLetterQueue.Id, headers)
+}
+
+// settle is a helper function that applies given task transition on behalf of the job with given ID
+// and marks the job as finished. Transition must report false if the job does not hold the task lease anymore.
+func (res *Jobs) settle(ctx context.Context, id string, transition func(job *models.Job) (bool, error)) (err error) {
+
+	// Retrieve record from the repo
+	record, err := res.jobsRepo.GetById(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "repository GetById failed")
+	}
+	if record == nil {
+		return errors.New("job does not exist")
+	}
+
+	// Update task
+	applied, err := transition(record)
+	if err != nil {
+		return errors.Wrap(err, "task transition failed")
+	}
+
+	// Finish job
+	_, err = res.jobsRepo.Finish(ctx, id, time.Now())
+	if err != nil {
+		return errors.Wrap(err, "repository Finish failed")
+	}
+	if record.WorkerId != "" {
+		err = res.workersRepo.RemoveJob(ctx, record.WorkerId, id)
+		if err != nil {
+			return errors.Wrap(err, "repository RemoveJob failed")
+		}
+	}
+	if !applied {
+		return errors.New("job does not hold the task lease anymore")
+	}
+
+	return
+}
+
+// validateJobLogLine checks that job log line is valid.
+func validateJobLogLine(line string) (err error) {
+	return validation.Validate(line, validation.Length(0, 4096))
+}
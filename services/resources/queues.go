@@ -2,23 +2,26 @@ package resources
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/go-ozzo/ozzo-validation"
-	"github.com/go-ozzo/ozzo-validation/is"
 	"github.com/gork-io/gork/models"
 	"github.com/pkg/errors"
 )
 
 // NewQueues creates a new instance of Queues.
-func NewQueues(queuesRepo models.QueuesRepository) (res *Queues) {
+func NewQueues(queuesRepo models.QueuesRepository, tasksRepo models.TasksRepository) (res *Queues) {
 	return &Queues{
 		queuesRepo: queuesRepo,
+		tasksRepo:  tasksRepo,
 	}
 }
 
 // Queues resource service implements operations that are related to the queues management.
 type Queues struct {
 	queuesRepo models.QueuesRepository // queues repository
+	tasksRepo  models.TasksRepository  // tasks repository
 }
 
 // List returns a subset of the queries, based on collection params given.
@@ -50,8 +53,7 @@ func (res *Queues) Create(
 	for key, value := range settings {
 		vErr["settings["+string(key)+"]"] = validateQueueSetting(key, value)
 	}
-	vErr.Filter()
-	if vErr != nil {
+	if err = vErr.Filter(); err != nil {
 		return nil, errors.Wrap(err, "validation error")
 	}
 	existing, err := res.queuesRepo.GetByName(ctx, name)
@@ -61,9 +63,14 @@ func (res *Queues) Create(
 	if existing != nil {
 		return nil, errors.New("queue with such name already exists")
 	}
+	err = res.checkDeadLetterQueue(ctx, name, settings[models.QueueSettingDeadLetterQueue])
+	if err != nil {
+		return nil, err
+	}
 
 	// Save record to the repo
-	err = res.queuesRepo.Save(ctx, models.NewQueue(name, settings))
+	record = models.NewQueue(name, settings)
+	err = res.queuesRepo.Save(ctx, record)
 	if err != nil {
 		return nil, errors.Wrap(err, "repository Save failed")
 	}
@@ -71,6 +78,56 @@ func (res *Queues) Create(
 	return
 }
 
+// Update overwrites given settings of the queue with given ID, keeping the rest of its settings intact.
+// Update is applied only if the queue is still at given version, so concurrent updates never overwrite
+// each other. Settings are read by every operation on the queue, so consumers pick them up immediately.
+func (res *Queues) Update(
+	ctx context.Context,
+	id string,
+	settings map[models.QueueSetting]string,
+	version int64,
+) (record *models.Queue, err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"settings": validation.Validate(settings, validation.Required),
+	}
+	for key, value := range settings {
+		vErr["settings["+string(key)+"]"] = validateQueueSetting(key, value)
+	}
+	if err = vErr.Filter(); err != nil {
+		return nil, errors.Wrap(err, "validation error")
+	}
+	record, err = res.queuesRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+	if record == nil {
+		return nil, errors.New("queue with such ID does not exist")
+	}
+	err = res.checkDeadLetterQueue(ctx, record.Name, settings[models.QueueSettingDeadLetterQueue])
+	if err != nil {
+		return nil, err
+	}
+
+	// Update record in the repo
+	updated, err := res.queuesRepo.UpdateSettings(ctx, id, settings, version)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository UpdateSettings failed")
+	}
+	if !updated {
+		return nil, errors.Errorf("queue version %d does not match, it was updated concurrently", version)
+	}
+
+	// Retrieve updated record
+	record, err = res.queuesRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+
+	return
+}
+
 // Read returns query by its ID.
 func (res *Queues) Read(ctx context.Context, id string) (record *models.Queue, err error) {
 
@@ -88,6 +145,142 @@ func (res *Queues) Delete(ctx context.Context, id string) (err error) {
 	return errors.Wrap(res.queuesRepo.Delete(ctx, id), "repository Delete failed")
 }
 
+// Pause stops delivery of the tasks of the queue with given ID, on all server nodes at once.
+// Paused queue still accepts new tasks.
+func (res *Queues) Pause(ctx context.Context, id string) (record *models.Queue, err error) {
+	return res.setPaused(ctx, id, true)
+}
+
+// Resume restarts delivery of the tasks of the paused queue with given ID.
+func (res *Queues) Resume(ctx context.Context, id string) (record *models.Queue, err error) {
+	return res.setPaused(ctx, id, false)
+}
+
+// setPaused is a helper function that pauses or resumes the queue with given ID and returns the updated queue.
+func (res *Queues) setPaused(ctx context.Context, id string, paused bool) (record *models.Queue, err error) {
+
+	// Update record in the repo
+	updated, err := res.queuesRepo.SetPaused(ctx, id, paused)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository SetPaused failed")
+	}
+	if !updated {
+		return nil, errors.New("queue with such ID does not exist")
+	}
+
+	// Retrieve updated record
+	record, err = res.queuesRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+
+	return
+}
+
+// Purge deletes all pending tasks of the queue with given ID, keeping the queue itself and its settings.
+// Delayed tasks are deleted as well, if requested. If dead-lettered tasks are requested as well, the tasks
+// that were dead-lettered from the queue are deleted from its dead-letter queue, according to the same rules.
+// Tasks leased to the jobs are never deleted. Returns the number of deleted tasks.
+func (res *Queues) Purge(ctx context.Context, id string, delayed bool, deadLettered bool) (count int, err error) {
+
+	// Retrieve record from the repo
+	record, err := res.queuesRepo.GetById(ctx, id)
+	if err != nil {
+		return 0, errors.Wrap(err, "repository GetById failed")
+	}
+	if record == nil {
+		return 0, errors.New("queue with such ID does not exist")
+	}
+
+	// Purge tasks of the queue
+	count, err = res.tasksRepo.Purge(ctx, record.Id, delayed, "")
+	if err != nil {
+		return count, errors.Wrap(err, "repository Purge failed")
+	}
+	if !deadLettered {
+		return
+	}
+
+	// Purge tasks dead-lettered from the queue
+	name := record.Settings[models.QueueSettingDeadLetterQueue]
+	if name == "" || name == record.Name {
+		return
+	}
+	deadLetterQueue, err := res.queuesRepo.GetByName(ctx, name)
+	if err != nil {
+		return count, errors.Wrap(err, "repository GetByName failed")
+	}
+	if deadLetterQueue == nil {
+		return
+	}
+	deadLetterCount, err := res.tasksRepo.Purge(ctx, deadLetterQueue.Id, delayed, record.Name)
+	count += deadLetterCount
+	if err != nil {
+		return count, errors.Wrap(err, "repository Purge failed")
+	}
+
+	return
+}
+
+// Stats returns live statistics of the queue with given ID: number of the tasks in every state, enqueue
+// and dequeue rates and the age of the oldest pending task.
+func (res *Queues) Stats(ctx context.Context, id string) (stats *models.QueueStats, err error) {
+
+	// Retrieve record from the repo
+	record, err := res.queuesRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+	if record == nil {
+		return nil, errors.New("queue with such ID does not exist")
+	}
+
+	// Retrieve stats from the repo
+	stats, err = res.tasksRepo.Stats(ctx, record.Id, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, "repository Stats failed")
+	}
+
+	return
+}
+
+// checkDeadLetterQueue is a helper function that checks that the dead-letter queue with given name exists
+// and that following the dead-letter queues from it never leads back to the queue with given name
+// or into another cycle.
+// Attempts of the task are reset on every move, so the task moved along a cycle would never settle.
+// Empty dead-letter queue name is valid, it disables dead-lettering.
+func (res *Queues) checkDeadLetterQueue(ctx context.Context, name string, deadLetterName string) (err error) {
+
+	if deadLetterName == "" {
+		return
+	}
+	if deadLetterName == name {
+		return errors.New("queue can not be its own dead-letter queue")
+	}
+
+	// Follow the dead-letter chain until it ends
+	visited := map[string]bool{name: true}
+	for next := deadLetterName; next != ""; {
+		if visited[next] {
+			return errors.Errorf("dead-letter queues starting from %q form a cycle", deadLetterName)
+		}
+		visited[next] = true
+		queue, err := res.queuesRepo.GetByName(ctx, next)
+		if err != nil {
+			return errors.Wrap(err, "repository GetByName failed")
+		}
+		if queue == nil {
+			if next == deadLetterName {
+				return errors.New("dead-letter queue with such name does not exist")
+			}
+			break // tasks reaching the missing queue are failed
+		}
+		next = queue.Settings[models.QueueSettingDeadLetterQueue]
+	}
+
+	return
+}
+
 // validateQueueName checks that queue name is valid.
 func validateQueueName(name string) (err error) {
 	return validation.Validate(name, validation.Length(1, 255))
@@ -99,11 +292,53 @@ func validateQueueSetting(name models.QueueSetting, value string) (err error) {
 	case models.QueueSettingRateLimitEnabled:
 		err = validation.Validate(value, validation.In("0", "1"))
 	case models.QueueSettingRateLimitTokens:
-		err = validation.Validate(value, is.Int)
+		err = validateIntSetting(value, 0, 1000000)
 	case models.QueueSettingRateLimitDuration:
-		err = validation.Validate(value, is.Int, validation.Max(86400))
+		err = validateIntSetting(value, 0, 86400)
+	case models.QueueSettingVisibilityTimeout:
+		err = validateIntSetting(value, 1, 86400)
+	case models.QueueSettingPriorityMode:
+		err = validation.Validate(value, validation.In(models.QueuePriorityModeStrict, models.QueuePriorityModeWeighted))
+	case models.QueueSettingPriorityWeight:
+		err = validateIntSetting(value, 0, 86400)
+	case models.QueueSettingRetryMaxAttempts:
+		err = validateIntSetting(value, 0, 1000000)
+	case models.QueueSettingRetryBackoffInitial, models.QueueSettingRetryBackoffMax:
+		err = validateIntSetting(value, 0, 86400)
+	case models.QueueSettingRetryBackoffMultiplier:
+		err = validateFloatSetting(value, 1, 100)
+	case models.QueueSettingRetryBackoffJitter:
+		err = validateFloatSetting(value, 0, 1)
+	case models.QueueSettingResultTtl:
+		err = validateIntSetting(value, 0, 2592000)
+	case models.QueueSettingIdempotencyWindow:
+		err = validateIntSetting(value, 0, 2592000)
+	case models.QueueSettingDeadLetterQueue:
+		err = validation.Validate(value, validation.Length(0, 255))
 	default:
 		err = errors.New("Unknown setting")
 	}
 	return
 }
+
+// validateIntSetting checks that setting value is an integer within given range.
+func validateIntSetting(value string, min, max int) (err error) {
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return errors.New("must be an integer")
+	}
+
+	return validation.Validate(number, validation.Min(min), validation.Max(max))
+}
+
+// validateFloatSetting checks that setting value is a number within given range.
+func validateFloatSetting(value string, min, max float64) (err error) {
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return errors.New("must be a number")
+	}
+
+	return validation.Validate(number, validation.Min(min), validation.Max(max))
+}
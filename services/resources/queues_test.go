@@ -0,0 +1,149 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	redis_repo "github.com/gork-io/gork/transformers/repositories/redis"
+)
+
+// newTestQueues creates queues service backed by in-memory redis server.
+func newTestQueues(t *testing.T) (server *miniredis.Miniredis, res *Queues) {
+
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start redis server: %v", err)
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	return server, NewQueues(redis_repo.NewQueuesRepository(client), redis_repo.NewTasksRepository(client))
+}
+
+func TestQueuesDeadLetterQueue(t *testing.T) {
+
+	ctx := context.Background()
+	server, res := newTestQueues(t)
+	defer server.Close()
+
+	deadLetter := func(name string) map[models.QueueSetting]string {
+		return map[models.QueueSetting]string{models.QueueSettingDeadLetterQueue: name}
+	}
+
+	// Chain a -> b -> c
+	if _, err := res.Create(ctx, "c", nil); err != nil {
+		t.Fatalf("Create(c) failed: %v", err)
+	}
+	b, err := res.Create(ctx, "b", deadLetter("c"))
+	if err != nil {
+		t.Fatalf("Create(b) failed: %v", err)
+	}
+	a, err := res.Create(ctx, "a", deadLetter("b"))
+	if err != nil {
+		t.Fatalf("Create(a) failed: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		apply    func() error
+		accepted bool
+	}{
+		{"create with missing queue", func() error {
+			_, err := res.Create(ctx, "x", deadLetter("missing"))
+			return err
+		}, false},
+		{"create with itself", func() error {
+			_, err := res.Create(ctx, "x", deadLetter("x"))
+			return err
+		}, false},
+		{"update with itself", func() error {
+			_, err := res.Update(ctx, a.Id, deadLetter("a"), a.Version)
+			return err
+		}, false},
+		{"update into two-queue cycle", func() error {
+			_, err := res.Update(ctx, b.Id, deadLetter("a"), b.Version)
+			return err
+		}, false},
+		{"update into three-queue cycle", func() error {
+			c, err := res.queuesRepo.GetByName(ctx, "c")
+			if err != nil {
+				return err
+			}
+			_, err = res.Update(ctx, c.Id, deadLetter("a"), c.Version)
+			return err
+		}, false},
+		{"update with missing queue", func() error {
+			_, err := res.Update(ctx, a.Id, deadLetter("missing"), a.Version)
+			return err
+		}, false},
+		{"update to shorter chain", func() error {
+			_, err := res.Update(ctx, a.Id, deadLetter("c"), a.Version)
+			return err
+		}, true},
+		{"create at the head of chain", func() error {
+			_, err := res.Create(ctx, "y", deadLetter("a"))
+			return err
+		}, true},
+	}
+
+	for _, c := range cases {
+		if err := c.apply(); (err == nil) != c.accepted {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+		}
+	}
+}
+
+func TestQueuesUpdate(t *testing.T) {
+
+	ctx := context.Background()
+	server, res := newTestQueues(t)
+	defer server.Close()
+
+	queue, err := res.Create(ctx, "q", map[models.QueueSetting]string{models.QueueSettingRetryMaxAttempts: "3"})
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	if queue.Version != 0 {
+		t.Fatalf("new queue version = %d, want 0", queue.Version)
+	}
+
+	// Update at the current version is applied and bumps the version, other settings are kept
+	updated, err := res.Update(ctx, queue.Id, map[models.QueueSetting]string{models.QueueSettingVisibilityTimeout: "5"}, 0)
+	if err != nil {
+		t.Fatalf("Update() failed: %v", err)
+	}
+	if updated.Version != 1 ||
+		updated.Settings[models.QueueSettingVisibilityTimeout] != "5" ||
+		updated.Settings[models.QueueSettingRetryMaxAttempts] != "3" {
+		t.Fatalf("updated queue = %+v, want version 1 with both settings", updated)
+	}
+
+	// Update at the stale version is rejected and changes nothing
+	if _, err := res.Update(ctx, queue.Id, map[models.QueueSetting]string{models.QueueSettingVisibilityTimeout: "10"}, 0); err == nil {
+		t.Fatal("Update() at stale version succeeded")
+	}
+	if record, err := res.Read(ctx, queue.Id); err != nil || record.Version != 1 || record.Settings[models.QueueSettingVisibilityTimeout] != "5" {
+		t.Fatalf("queue after rejected update = %+v, %v, want version 1 unchanged", record, err)
+	}
+
+	// Invalid or empty settings and missing queues are rejected
+	invalid := []struct {
+		id       string
+		settings map[models.QueueSetting]string
+	}{
+		{queue.Id, nil},
+		{queue.Id, map[models.QueueSetting]string{"unknown": "1"}},
+		{queue.Id, map[models.QueueSetting]string{models.QueueSettingRetryBackoffJitter: "5"}},
+		{"missing", map[models.QueueSetting]string{models.QueueSettingVisibilityTimeout: "10"}},
+	}
+	for _, c := range invalid {
+		if _, err := res.Update(ctx, c.id, c.settings, 1); err == nil {
+			t.Errorf("Update(%s, %v) succeeded, want error", c.id, c.settings)
+		}
+	}
+	if record, err := res.Read(ctx, queue.Id); err != nil || record.Version != 1 {
+		t.Fatalf("queue after invalid updates = %+v, %v, want version 1", record, err)
+	}
+}
@@ -0,0 +1,158 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+// NewSchedules creates a new instance of Schedules.
+func NewSchedules(schedulesRepo models.SchedulesRepository, queuesRepo models.QueuesRepository) (res *Schedules) {
+	return &Schedules{
+		schedulesRepo: schedulesRepo,
+		queuesRepo:    queuesRepo,
+	}
+}
+
+// Schedules resource service implements operations that are related to the recurring tasks management.
+type Schedules struct {
+	schedulesRepo models.SchedulesRepository // schedules repository
+	queuesRepo    models.QueuesRepository    // queues repository
+}
+
+// List returns a subset of the schedules, based on collection params given.
+func (res *Schedules) List(
+	ctx context.Context,
+	params *models.CollectionParams,
+) (records []*models.Schedule, info *models.CollectionInfo, err error) {
+
+	// Retrieve collection from the repo
+	records, info, err = res.schedulesRepo.Find(ctx, params)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository Find failed")
+	}
+
+	return
+}
+
+// Create creates a new schedule that puts tasks into the queue with given name at every firing time
+// of the cron expression.
+func (res *Schedules) Create(
+	ctx context.Context,
+	queueName string,
+	expression string,
+	priority uint8,
+	headers map[string]string,
+	input []byte,
+) (record *models.Schedule, err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"queue":      validateQueueName(queueName),
+		"expression": validateScheduleExpression(expression),
+	}
+	for key := range headers {
+		vErr["headers["+key+"]"] = validateTaskHeaderKey(key)
+	}
+	if err = vErr.Filter(); err != nil {
+		return nil, errors.Wrap(err, "validation error")
+	}
+	queue, err := res.queuesRepo.GetByName(ctx, queueName)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetByName failed")
+	}
+	if queue == nil {
+		return nil, errors.New("queue with such name does not exist")
+	}
+
+	// Save record to the repo
+	cron, _ := models.ParseCron(expression)
+	record = models.NewSchedule(queue.Id, expression, priority, headers, input, cron.Next(time.Now()))
+	err = res.schedulesRepo.Save(ctx, record)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository Save failed")
+	}
+
+	return
+}
+
+// Read returns schedule by its ID.
+func (res *Schedules) Read(ctx context.Context, id string) (record *models.Schedule, err error) {
+
+	// Retrieve record from the repo
+	record, err = res.schedulesRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+
+	return
+}
+
+// Update replaces cron expression and task template of the schedule with given ID.
+// Next firing time is calculated from the new expression.
+func (res *Schedules) Update(
+	ctx context.Context,
+	id string,
+	expression string,
+	priority uint8,
+	headers map[string]string,
+	input []byte,
+) (record *models.Schedule, err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"expression": validateScheduleExpression(expression),
+	}
+	for key := range headers {
+		vErr["headers["+key+"]"] = validateTaskHeaderKey(key)
+	}
+	if err = vErr.Filter(); err != nil {
+		return nil, errors.Wrap(err, "validation error")
+	}
+	record, err = res.schedulesRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+	if record == nil {
+		return nil, errors.New("schedule does not exist")
+	}
+
+	// Save record to the repo
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+	cron, _ := models.ParseCron(expression)
+	record.Expression = expression
+	record.Priority = priority
+	record.Headers = headers
+	record.Input = input
+	record.NextRunAt = cron.Next(time.Now())
+	err = res.schedulesRepo.Save(ctx, record)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository Save failed")
+	}
+
+	return
+}
+
+// Delete removes schedule with given ID from the repository.
+func (res *Schedules) Delete(ctx context.Context, id string) (err error) {
+	return errors.Wrap(res.schedulesRepo.Delete(ctx, id), "repository Delete failed")
+}
+
+// validateScheduleExpression checks that cron expression is valid and fires at least once.
+func validateScheduleExpression(expression string) (err error) {
+
+	cron, err := models.ParseCron(expression)
+	if err != nil {
+		return err
+	}
+	if cron.Next(time.Now()).IsZero() {
+		return errors.New("never fires")
+	}
+
+	return
+}
@@ -0,0 +1,319 @@
+package resources
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+// NewTasks creates a new instance of Tasks.
+func NewTasks(
+	tasksRepo models.TasksRepository,
+	queuesRepo models.QueuesRepository,
+	exchangesRepo models.ExchangesRepository,
+	bindingsRepo models.ExchangeBindingsRepository,
+	routers *ExchangeRouters,
+) (res *Tasks) {
+	return &Tasks{
+		tasksRepo:     tasksRepo,
+		queuesRepo:    queuesRepo,
+		exchangesRepo: exchangesRepo,
+		bindingsRepo:  bindingsRepo,
+		routers:       routers,
+	}
+}
+
+// Tasks resource service implements operations that are related to the tasks publishing and management.
+type Tasks struct {
+	tasksRepo     models.TasksRepository            // tasks repository
+	queuesRepo    models.QueuesRepository           // queues repository
+	exchangesRepo models.ExchangesRepository        // exchanges repository
+	bindingsRepo  models.ExchangeBindingsRepository // exchange bindings repository
+	routers       *ExchangeRouters                  // compiled exchange routers
+}
+
+// Publish creates a new task instance and puts it into the queue with given name.
+// Task is not delivered until the run time of the options, if it is set.
+// If idempotency key is set, publishing with the same key again within the idempotency window of the queue
+// creates no task and returns the task that was published first. Key is rejected if the window is disabled.
+// If uniqueness key is set, publishing with the same key again creates no task and returns the task holding
+// the key, until that task is finished, failed, cancelled, expired or dead-lettered. If both keys are set,
+// the idempotency key is checked first, and it is not taken if the uniqueness key is held.
+func (res *Tasks) Publish(
+	ctx context.Context,
+	queueName string,
+	priority uint8,
+	headers map[string]string,
+	input []byte,
+	options models.PublishOptions,
+) (record *models.Task, err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"queue":          validateQueueName(queueName),
+		"expiresAt":      validateTaskExpiresAt(options.ExpiresAt),
+		"runAt":          validateTaskRunAt(options.RunAt, options.ExpiresAt),
+		"idempotencyKey": validation.Validate(options.IdempotencyKey, validation.Length(0, 255)),
+		"uniqueKey":      validation.Validate(options.UniqueKey, validation.Length(0, 255)),
+	}
+	for key := range headers {
+		vErr["headers["+key+"]"] = validateTaskHeaderKey(key)
+	}
+	if err = vErr.Filter(); err != nil {
+		return nil, errors.Wrap(err, "validation error")
+	}
+	queue, err := res.queuesRepo.GetByName(ctx, queueName)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetByName failed")
+	}
+	if queue == nil {
+		return nil, errors.New("queue with such name does not exist")
+	}
+	window, _ := strconv.Atoi(queue.Settings[models.QueueSettingIdempotencyWindow])
+	if options.IdempotencyKey != "" && window <= 0 {
+		return nil, errors.New("idempotency is disabled for the queue")
+	}
+
+	// Save record to the repo, unless the idempotency or uniqueness key is taken
+	record = models.NewTask(queue.Id, priority, headers, input, options.ExpiresAt, options.RunAt)
+	record.UniqueKey = options.UniqueKey
+	var id string
+	switch {
+	case options.IdempotencyKey != "":
+		id, err = res.tasksRepo.EnqueueIdempotent(ctx, record, options.IdempotencyKey, time.Duration(window)*time.Second)
+		if err != nil {
+			return nil, errors.Wrap(err, "repository EnqueueIdempotent failed")
+		}
+	case options.UniqueKey != "":
+		id, err = res.tasksRepo.EnqueueUnique(ctx, record)
+		if err != nil {
+			return nil, errors.Wrap(err, "repository EnqueueUnique failed")
+		}
+	default:
+		_, err = res.tasksRepo.Enqueue(ctx, record)
+		if err != nil {
+			return nil, errors.Wrap(err, "repository Enqueue failed")
+		}
+		return
+	}
+	if id == record.Id {
+		return
+	}
+
+	// Return the task holding the key
+	record, err = res.tasksRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+	if record == nil {
+		return nil, errors.New("task holding the key does not exist")
+	}
+
+	return
+}
+
+// PublishToExchange creates a copy of the task for every queue the exchange with given name routes it to,
+// based on the routing key or the task headers, depending on the exchange type.
+// Returns no tasks if the exchange routes the task nowhere. Idempotency and uniqueness keys are not supported.
+func (res *Tasks) PublishToExchange(
+	ctx context.Context,
+	exchangeName string,
+	routingKey string,
+	priority uint8,
+	headers map[string]string,
+	input []byte,
+	options models.PublishOptions,
+) (records []*models.Task, err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"exchange":   validateExchangeName(exchangeName),
+		"routingKey": validateExchangeRoutingKey(routingKey),
+		"expiresAt":  validateTaskExpiresAt(options.ExpiresAt),
+		"runAt":      validateTaskRunAt(options.RunAt, options.ExpiresAt),
+	}
+	if options.IdempotencyKey != "" || options.UniqueKey != "" {
+		vErr["options"] = errors.New("idempotency and uniqueness keys are not supported by exchanges")
+	}
+	for key := range headers {
+		vErr["headers["+key+"]"] = validateTaskHeaderKey(key)
+	}
+	if err = vErr.Filter(); err != nil {
+		return nil, errors.Wrap(err, "validation error")
+	}
+	exchange, err := res.exchangesRepo.GetByName(ctx, exchangeName)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetByName failed")
+	}
+	if exchange == nil {
+		return nil, errors.New("exchange with such name does not exist")
+	}
+
+	// Resolve target queues
+	queueIds, err := res.route(ctx, exchange, routingKey, headers)
+	if err != nil {
+		return nil, errors.Wrap(err, "routing failed")
+	}
+
+	// Save records to the repo
+	for _, queueId := range queueIds {
+		taskHeaders := make(map[string]string, len(headers))
+		for key, value := range headers {
+			taskHeaders[key] = value
+		}
+		record := models.NewTask(queueId, priority, taskHeaders, input, options.ExpiresAt, options.RunAt)
+		_, err = res.tasksRepo.Enqueue(ctx, record)
+		if err != nil {
+			return nil, errors.Wrap(err, "repository Enqueue failed")
+		}
+		records = append(records, record)
+	}
+
+	return
+}
+
+// Read returns task by its ID.
+func (res *Tasks) Read(ctx context.Context, id string) (record *models.Task, err error) {
+
+	// Retrieve record from the repo
+	record, err = res.tasksRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+
+	return
+}
+
+// Result returns task with given ID and reports whether its processing is over, so its status is final.
+// Output of the successfully finished task is available until the result TTL of the queue passes.
+func (res *Tasks) Result(ctx context.Context, id string) (record *models.Task, ready bool, err error) {
+
+	// Retrieve record from the repo
+	record, err = res.tasksRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "repository GetById failed")
+	}
+	if record == nil {
+		return nil, false, errors.New("task does not exist")
+	}
+
+	switch record.Status {
+	case models.TaskStatusFinished, models.TaskStatusFailed, models.TaskStatusCancelled, models.TaskStatusExpired:
+		ready = true
+	}
+
+	return
+}
+
+// List returns a subset of the tasks of the queue with given ID, based on collection params given.
+func (res *Tasks) List(
+	ctx context.Context,
+	queueId string,
+	params *models.CollectionParams,
+) (records []*models.Task, info *models.CollectionInfo, err error) {
+
+	// Retrieve collection from the repo
+	records, info, err = res.tasksRepo.FindByQueue(ctx, queueId, params)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository FindByQueue failed")
+	}
+
+	return
+}
+
+// Cancel prevents pending, delayed or waiting task with given ID from being delivered to the workers.
+// Tasks of the workflow that depend on it are cancelled as well.
+func (res *Tasks) Cancel(ctx context.Context, id string) (err error) {
+
+	cancelled, err := res.tasksRepo.Cancel(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "repository Cancel failed")
+	}
+	if !cancelled {
+		return errors.New("task does not exist or is not pending, delayed or waiting anymore")
+	}
+
+	return
+}
+
+// CountExpired returns the number of tasks of the queue with given ID that expired before delivery.
+func (res *Tasks) CountExpired(ctx context.Context, queueId string) (count uint64, err error) {
+
+	// Retrieve counter from the repo
+	count, err = res.tasksRepo.CountExpired(ctx, queueId)
+	if err != nil {
+		return 0, errors.Wrap(err, "repository CountExpired failed")
+	}
+
+	return
+}
+
+// route is a helper function that returns IDs of the existing queues whose bindings to the exchange given
+// match the routing key or the headers. Every queue is returned once, even if several of its bindings match.
+func (res *Tasks) route(
+	ctx context.Context,
+	exchange *models.Exchange,
+	routingKey string,
+	headers map[string]string,
+) (queueIds []string, err error) {
+
+	// Compile router, unless bindings did not change since the last time.
+	// Version is read before the bindings, so router is never cached for the version older than its bindings.
+	version, err := res.bindingsRepo.GetVersion(ctx, exchange.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetVersion failed")
+	}
+	router := res.routers.get(exchange.Id, version)
+	if router == nil {
+		bindings, err := res.bindingsRepo.FindByExchange(ctx, exchange.Id)
+		if err != nil {
+			return nil, errors.Wrap(err, "repository FindByExchange failed")
+		}
+		router = models.NewExchangeRouter(exchange, bindings)
+		res.routers.put(exchange.Id, version, router)
+	}
+
+	queueIds = router.Route(routingKey, headers)
+	if len(queueIds) == 0 {
+		return
+	}
+
+	// Skip queues that do not exist anymore
+	queues, err := res.queuesRepo.MGetById(ctx, queueIds)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository MGetById failed")
+	}
+	queueIds = queueIds[:0]
+	for _, queue := range queues {
+		if queue != nil {
+			queueIds = append(queueIds, queue.Id)
+		}
+	}
+
+	return
+}
+
+// validateTaskExpiresAt checks that task expiration time is either not set or is in the future.
+func validateTaskExpiresAt(expiresAt time.Time) (err error) {
+	if !expiresAt.IsZero() && !expiresAt.After(time.Now()) {
+		return errors.New("must be in the future")
+	}
+	return
+}
+
+// validateTaskRunAt checks that task run time is either not set or is before the expiration time of the task.
+func validateTaskRunAt(runAt, expiresAt time.Time) (err error) {
+	if !runAt.IsZero() && !expiresAt.IsZero() && !runAt.Before(expiresAt) {
+		return errors.New("must be before the expiration time")
+	}
+	return
+}
+
+// validateTaskHeaderKey checks that task header key is valid.
+func validateTaskHeaderKey(key string) (err error) {
+	return validation.Validate(key, validation.Required, validation.Length(1, 255))
+}
@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+// NewWorkers creates a new instance of Workers.
+func NewWorkers(workersRepo models.WorkersRepository, queuesRepo models.QueuesRepository) (res *Workers) {
+	return &Workers{
+		workersRepo: workersRepo,
+		queuesRepo:  queuesRepo,
+	}
+}
+
+// Workers resource service implements operations that are related to the workers registry.
+type Workers struct {
+	workersRepo models.WorkersRepository // workers repository
+	queuesRepo  models.QueuesRepository  // queues repository
+}
+
+// List returns a subset of the live workers, based on collection params given.
+func (res *Workers) List(
+	ctx context.Context,
+	params *models.CollectionParams,
+) (records []*models.Worker, info *models.CollectionInfo, err error) {
+
+	// Retrieve collection from the repo
+	records, info, err = res.workersRepo.Find(ctx, params)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository Find failed")
+	}
+
+	return
+}
+
+// Register creates a new live worker that consumes jobs from the queues with given names.
+func (res *Workers) Register(
+	ctx context.Context,
+	name string,
+	hostname string,
+	version string,
+	queueNames []string,
+	concurrency uint32,
+) (record *models.Worker, err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"name":        validation.Validate(name, validation.Required, validation.Length(1, 255)),
+		"hostname":    validation.Validate(hostname, validation.Length(0, 255)),
+		"version":     validation.Validate(version, validation.Length(0, 255)),
+		"queues":      validation.Validate(queueNames, validation.Required),
+		"concurrency": validation.Validate(int(concurrency), validation.Max(10000)),
+	}
+	if err = vErr.Filter(); err != nil {
+		return nil, errors.Wrap(err, "validation error")
+	}
+	for _, queueName := range queueNames {
+		queue, err := res.queuesRepo.GetByName(ctx, queueName)
+		if err != nil {
+			return nil, errors.Wrap(err, "repository GetByName failed")
+		}
+		if queue == nil {
+			return nil, errors.Errorf("queue %q does not exist", queueName)
+		}
+	}
+
+	// Save record to the repo
+	if concurrency == 0 {
+		concurrency = 1
+	}
+	record = models.NewWorker(name, hostname, version, queueNames, concurrency)
+	err = res.workersRepo.Save(ctx, record)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository Save failed")
+	}
+
+	return
+}
+
+// Read returns worker by its ID.
+func (res *Workers) Read(ctx context.Context, id string) (record *models.Worker, err error) {
+
+	// Retrieve record from the repo
+	record, err = res.workersRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository GetById failed")
+	}
+
+	return
+}
+
+// Heartbeat reports that worker with given ID is still alive.
+// Worker that is already marked as dead must register again.
+func (res *Workers) Heartbeat(ctx context.Context, id string) (err error) {
+
+	alive, err := res.workersRepo.Heartbeat(ctx, id, time.Now())
+	if err != nil {
+		return errors.Wrap(err, "repository Heartbeat failed")
+	}
+	if !alive {
+		return errors.New("worker does not exist or is dead")
+	}
+
+	return
+}
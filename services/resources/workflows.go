@@ -0,0 +1,243 @@
+package resources
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-ozzo/ozzo-validation"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+// NewWorkflows creates a new instance of Workflows.
+func NewWorkflows(
+	workflowsRepo models.WorkflowsRepository,
+	tasksRepo models.TasksRepository,
+	queuesRepo models.QueuesRepository,
+) (res *Workflows) {
+	return &Workflows{
+		workflowsRepo: workflowsRepo,
+		tasksRepo:     tasksRepo,
+		queuesRepo:    queuesRepo,
+	}
+}
+
+// Workflows resource service implements operations that are related to the graphs of dependent tasks.
+type Workflows struct {
+	workflowsRepo models.WorkflowsRepository // workflows repository
+	tasksRepo     models.TasksRepository     // tasks repository
+	queuesRepo    models.QueuesRepository    // queues repository
+}
+
+// List returns a subset of the workflows, based on collection params given.
+func (res *Workflows) List(
+	ctx context.Context,
+	params *models.CollectionParams,
+) (records []*models.Workflow, info *models.CollectionInfo, err error) {
+
+	// Retrieve collection from the repo
+	records, info, err = res.workflowsRepo.Find(ctx, params)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository Find failed")
+	}
+
+	// Resolve statuses
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+		if _, err = res.resolve(ctx, record); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return
+}
+
+// Create submits a new workflow with given tasks. Every task is delivered only after all the tasks it depends on
+// are finished successfully. Once a task fails permanently, all tasks that depend on it are cancelled.
+// Returns tasks in the order of the specs given.
+func (res *Workflows) Create(
+	ctx context.Context,
+	name string,
+	specs []*models.WorkflowTaskSpec,
+) (record *models.Workflow, tasks []*models.Task, err error) {
+
+	// Validate input
+	vErr := validation.Errors{
+		"name":  validation.Validate(name, validation.Length(0, 255)),
+		"tasks": validation.Validate(specs, validation.Required, validation.Length(1, 1000)),
+	}
+	for i, spec := range specs {
+		vErr["tasks["+strconv.Itoa(i)+"]"] = validateWorkflowTaskSpec(spec)
+	}
+	if err = vErr.Filter(); err != nil {
+		return nil, nil, errors.Wrap(err, "validation error")
+	}
+	if err = validateWorkflowGraph(specs); err != nil {
+		return nil, nil, errors.Wrap(err, "validation error")
+	}
+
+	// Create tasks, resolving every queue once
+	queues := make(map[string]*models.Queue)
+	byKey := make(map[string]*models.Task, len(specs))
+	for _, spec := range specs {
+		queue, ok := queues[spec.Queue]
+		if !ok {
+			queue, err = res.queuesRepo.GetByName(ctx, spec.Queue)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "repository GetByName failed")
+			}
+			if queue == nil {
+				return nil, nil, errors.Errorf("queue %q does not exist", spec.Queue)
+			}
+			queues[spec.Queue] = queue
+		}
+		task := models.NewTask(queue.Id, spec.Priority, spec.Headers, spec.Input, time.Time{}, time.Time{})
+		byKey[spec.Key] = task
+		tasks = append(tasks, task)
+	}
+
+	// Link tasks
+	record = models.NewWorkflow(name)
+	for i, spec := range specs {
+		var parentIds []string
+		for _, key := range spec.DependsOn {
+			parentIds = append(parentIds, byKey[key].Id)
+		}
+		record.AddTask(tasks[i], spec.Key, parentIds)
+	}
+
+	// Save records to the repo
+	err = res.workflowsRepo.Save(ctx, record, tasks)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository Save failed")
+	}
+
+	return
+}
+
+// Read returns workflow by its ID along with its tasks, in the order of submission.
+func (res *Workflows) Read(ctx context.Context, id string) (record *models.Workflow, tasks []*models.Task, err error) {
+
+	// Retrieve record from the repo
+	record, err = res.workflowsRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "repository GetById failed")
+	}
+	if record == nil {
+		return nil, nil, nil
+	}
+
+	// Resolve status
+	tasks, err = res.resolve(ctx, record)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return
+}
+
+// resolve is a helper function that retrieves tasks of the workflow given and derives its status from them.
+// Workflow is failed once any of its tasks fails, expires or is cancelled, and finished once all of them finish.
+func (res *Workflows) resolve(ctx context.Context, record *models.Workflow) (tasks []*models.Task, err error) {
+
+	ids := make([]string, 0, len(record.Nodes))
+	for _, node := range record.Nodes {
+		ids = append(ids, node.TaskId)
+	}
+	tasks, err = res.tasksRepo.MGetById(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "repository MGetById failed")
+	}
+
+	record.Status = models.WorkflowStatusFinished
+	for _, task := range tasks {
+		if task == nil {
+			continue
+		}
+		switch task.Status {
+		case models.TaskStatusFinished:
+		case models.TaskStatusFailed, models.TaskStatusExpired, models.TaskStatusCancelled:
+			record.Status = models.WorkflowStatusFailed
+			return
+		default:
+			record.Status = models.WorkflowStatusRunning
+		}
+	}
+
+	return
+}
+
+// validateWorkflowTaskSpec checks that workflow task spec is valid.
+func validateWorkflowTaskSpec(spec *models.WorkflowTaskSpec) (err error) {
+
+	if spec == nil {
+		return errors.New("must not be empty")
+	}
+	vErr := validation.Errors{
+		"key":       validation.Validate(spec.Key, validation.Required, validation.Length(1, 255)),
+		"queue":     validation.Validate(spec.Queue, validation.Required, validation.Length(1, 255)),
+		"dependsOn": validation.Validate(spec.DependsOn, validation.Length(0, 100)),
+	}
+	for key := range spec.Headers {
+		vErr["headers["+key+"]"] = validateTaskHeaderKey(key)
+	}
+
+	return vErr.Filter()
+}
+
+// validateWorkflowGraph checks that keys of the workflow tasks are unique, dependencies refer to the known keys
+// and the dependencies graph has no cycles.
+func validateWorkflowGraph(specs []*models.WorkflowTaskSpec) (err error) {
+
+	// Count parents of every task
+	parents := make(map[string]int, len(specs))
+	children := make(map[string][]string, len(specs))
+	for _, spec := range specs {
+		if _, ok := parents[spec.Key]; ok {
+			return errors.Errorf("task key %q is not unique", spec.Key)
+		}
+		parents[spec.Key] = 0
+	}
+	for _, spec := range specs {
+		seen := make(map[string]bool, len(spec.DependsOn))
+		for _, key := range spec.DependsOn {
+			if _, ok := parents[key]; !ok {
+				return errors.Errorf("task %q depends on unknown task %q", spec.Key, key)
+			}
+			if seen[key] {
+				return errors.Errorf("task %q depends on task %q more than once", spec.Key, key)
+			}
+			seen[key] = true
+			parents[spec.Key]++
+			children[key] = append(children[key], spec.Key)
+		}
+	}
+
+	// Remove tasks without parents one by one, tasks that are left form a cycle
+	var ready []string
+	for key, count := range parents {
+		if count == 0 {
+			ready = append(ready, key)
+		}
+	}
+	removed := 0
+	for len(ready) > 0 {
+		key := ready[len(ready)-1]
+		ready = ready[:len(ready)-1]
+		removed++
+		for _, child := range children[key] {
+			parents[child]--
+			if parents[child] == 0 {
+				ready = append(ready, child)
+			}
+		}
+	}
+	if removed < len(specs) {
+		return errors.New("task dependencies form a cycle")
+	}
+
+	return
+}
@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/gork-io/gork/models"
+)
+
+func TestValidateWorkflowGraph(t *testing.T) {
+
+	spec := func(key string, dependsOn ...string) *models.WorkflowTaskSpec {
+		return &models.WorkflowTaskSpec{Key: key, Queue: "q", DependsOn: dependsOn}
+	}
+
+	cases := []struct {
+		name  string
+		specs []*models.WorkflowTaskSpec
+		valid bool
+	}{
+		{"empty", nil, true},
+		{"single", []*models.WorkflowTaskSpec{spec("a")}, true},
+		{"chain", []*models.WorkflowTaskSpec{spec("c", "b"), spec("b", "a"), spec("a")}, true},
+		{"diamond", []*models.WorkflowTaskSpec{spec("a"), spec("b", "a"), spec("c", "a"), spec("d", "b", "c")}, true},
+		{"independent roots", []*models.WorkflowTaskSpec{spec("a"), spec("b"), spec("c", "a", "b")}, true},
+		{"duplicate key", []*models.WorkflowTaskSpec{spec("a"), spec("a")}, false},
+		{"duplicate dependency", []*models.WorkflowTaskSpec{spec("a"), spec("b", "a", "a")}, false},
+		{"missing parent", []*models.WorkflowTaskSpec{spec("a"), spec("b", "x")}, false},
+		{"self cycle", []*models.WorkflowTaskSpec{spec("a", "a")}, false},
+		{"two-node cycle", []*models.WorkflowTaskSpec{spec("a", "b"), spec("b", "a")}, false},
+		{"cycle behind root", []*models.WorkflowTaskSpec{spec("r"), spec("a", "r", "c"), spec("b", "a"), spec("c", "b")}, false},
+	}
+
+	for _, c := range cases {
+		err := validateWorkflowGraph(c.specs)
+		if (err == nil) != c.valid {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+		}
+	}
+}
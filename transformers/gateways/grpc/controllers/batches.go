@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"github.com/gork-io/gork/models"
+	"github.com/gork-io/gork/services/resources"
+	"github.com/gork-io/gork/transformers/gateways/grpc/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// NewBatches creates a new instance of Batches.
+func NewBatches(batchesSvc *resources.Batches) (ctrl *Batches) {
+	return &Batches{
+		batchesSvc: batchesSvc,
+	}
+}
+
+// Batches controller is a proxy that links GRPC gateway with service layer.
+type Batches struct {
+	batchesSvc *resources.Batches // batches service
+}
+
+// Register registers this controller as a GRPC service implementation.
+func (ctrl *Batches) Register(server *grpc.Server) {
+	proto.RegisterBatchesServer(server, ctrl)
+}
+
+// List returns a subset of the batches, based on collection params given.
+func (ctrl *Batches) List(ctx context.Context, request *proto.BatchesCmds_List_Request) (response *proto.BatchesCmds_List_Response, err error) {
+
+	// Fetch records
+	records, info, err := ctrl.batchesSvc.List(ctx, unmarshalCollectionParams(request.Params))
+	if err != nil {
+		return nil, errors.Wrap(err, "list failed")
+	}
+
+	// Return response
+	response = &proto.BatchesCmds_List_Response{
+		Info: marshalCollectionInfo(info),
+	}
+	for _, record := range records {
+		response.Records = append(response.Records, marshalBatch(record))
+	}
+
+	return
+}
+
+// Create submits a new batch of tasks with a completion callback.
+func (ctrl *Batches) Create(ctx context.Context, request *proto.BatchesCmds_Create_Request) (response *proto.BatchesCmds_Create_Response, err error) {
+
+	// Create records
+	var specs []*models.BatchTaskSpec
+	for _, spec := range request.Tasks {
+		specs = append(specs, unmarshalBatchTaskSpec(spec))
+	}
+	record, tasks, err := ctrl.batchesSvc.Create(
+		ctx,
+		request.Name,
+		specs,
+		unmarshalBatchTaskSpec(request.Callback),
+		request.IncludeResults,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "create failed")
+	}
+
+	// Return response
+	response = &proto.BatchesCmds_Create_Response{
+		Record: marshalBatch(record),
+	}
+	for _, task := range tasks {
+		response.Tasks = append(response.Tasks, marshalTask(task))
+	}
+
+	return
+}
+
+// Read returns batch by its id, including the progress counters.
+func (ctrl *Batches) Read(ctx context.Context, request *proto.BatchesCmds_Read_Request) (response *proto.BatchesCmds_Read_Response, err error) {
+
+	// Fetch record
+	record, err := ctrl.batchesSvc.Read(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "read failed")
+	}
+
+	// Return response
+	response = &proto.BatchesCmds_Read_Response{
+		Record: marshalBatch(record),
+	}
+
+	return
+}
+
+// marshalBatch is a helper function that marshals domain model of the batch into GRCP model.
+func marshalBatch(input *models.Batch) (output *proto.Batch) {
+
+	if input == nil {
+		return nil
+	}
+
+	return &proto.Batch{
+		Id:             input.Id,
+		Name:           input.Name,
+		Total:          input.Total,
+		Succeeded:      input.Succeeded,
+		Failed:         input.Failed,
+		CallbackTaskId: input.CallbackTaskId,
+		IncludeResults: input.IncludeResults,
+		CreatedAt:      marshalTime(input.CreatedAt),
+		FinishedAt:     marshalTime(input.FinishedAt),
+	}
+}
+
+// unmarshalBatchTaskSpec is a helper function that unmarshals GRPC model of the batch task spec into domain model.
+func unmarshalBatchTaskSpec(input *proto.BatchesCmds_Create_Spec) (output *models.BatchTaskSpec) {
+
+	if input == nil {
+		return nil
+	}
+
+	return &models.BatchTaskSpec{
+		Queue:    input.Queue,
+		Priority: input.Priority,
+		Headers:  unmarshalHeaders(input.Headers),
+		Input:    input.Input,
+	}
+}
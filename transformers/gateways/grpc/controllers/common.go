@@ -1,10 +1,22 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/gork-io/gork/models"
 	"github.com/gork-io/gork/transformers/gateways/grpc/proto"
 )
 
+// unmarshalCollectionParams is a helper function that unmarshals GRPC model of the collection params into domain model.
+func unmarshalCollectionParams(input *proto.Collection_Params) (output *models.CollectionParams) {
+
+	if input == nil {
+		return models.NewCollectionParams("0", 0)
+	}
+
+	return models.NewCollectionParams(input.Cursor, input.Limit)
+}
+
 // marshalCollectionInfo is a helper function that marshals domain model of the collection info into GRCP model.
 func marshalCollectionInfo(input *models.CollectionInfo) (output *proto.Collection_Info) {
 
@@ -17,3 +29,45 @@ func marshalCollectionInfo(input *models.CollectionInfo) (output *proto.Collecti
 		Total:  input.Total,
 	}
 }
+
+// marshalTime is a helper function that marshals time into GRPC model.
+// Zero time is marshaled into an empty string.
+func marshalTime(input time.Time) (output string) {
+
+	if input.IsZero() {
+		return ""
+	}
+
+	return input.Format(time.RFC3339Nano)
+}
+
+// unmarshalTime is a helper function that unmarshals time from GRPC model.
+// An empty string is unmarshaled into zero time.
+func unmarshalTime(input string) (output time.Time, err error) {
+
+	if input == "" {
+		return
+	}
+
+	return time.Parse(time.RFC3339Nano, input)
+}
+
+// marshalHeaders is a helper function that marshals task headers into GRPC model.
+func marshalHeaders(input map[string]string) (output []*proto.Task_Header) {
+	for key, value := range input {
+		output = append(output, &proto.Task_Header{
+			Key:   key,
+			Value: value,
+		})
+	}
+	return
+}
+
+// unmarshalHeaders is a helper function that unmarshals task headers from GRPC model.
+func unmarshalHeaders(input []*proto.Task_Header) (output map[string]string) {
+	output = make(map[string]string)
+	for _, header := range input {
+		output[header.Key] = header.Value
+	}
+	return
+}
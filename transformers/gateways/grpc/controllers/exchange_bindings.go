@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"github.com/gork-io/gork/models"
+	"github.com/gork-io/gork/services/resources"
+	"github.com/gork-io/gork/transformers/gateways/grpc/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// NewExchangeBindings creates a new instance of ExchangeBindings.
+func NewExchangeBindings(bindingsSvc *resources.ExchangeBindings) (ctrl *ExchangeBindings) {
+	return &ExchangeBindings{
+		bindingsSvc: bindingsSvc,
+	}
+}
+
+// ExchangeBindings controller is a proxy that links GRPC gateway with service layer.
+type ExchangeBindings struct {
+	bindingsSvc *resources.ExchangeBindings // exchange bindings service
+}
+
+// Register registers this controller as a GRPC service implementation.
+func (ctrl *ExchangeBindings) Register(server *grpc.Server) {
+	proto.RegisterExchangeBindingsServer(server, ctrl)
+}
+
+// List returns all bindings of the exchange.
+func (ctrl *ExchangeBindings) List(ctx context.Context, request *proto.ExchangeBindingsCmds_List_Request) (response *proto.ExchangeBindingsCmds_List_Response, err error) {
+
+	// Fetch records
+	records, err := ctrl.bindingsSvc.List(ctx, request.ExchangeId)
+	if err != nil {
+		return nil, errors.Wrap(err, "list failed")
+	}
+
+	// Return response
+	response = &proto.ExchangeBindingsCmds_List_Response{}
+	for _, record := range records {
+		response.Records = append(response.Records, marshalExchangeBinding(record))
+	}
+
+	return
+}
+
+// Create binds the queue to the exchange.
+func (ctrl *ExchangeBindings) Create(ctx context.Context, request *proto.ExchangeBindingsCmds_Create_Request) (response *proto.ExchangeBindingsCmds_Create_Response, err error) {
+
+	// Convert rules
+	var rules []*models.ExchangeBindingRule
+	for _, rule := range request.Rules {
+		rules = append(rules, unmarshalExchangeBindingRule(rule))
+	}
+
+	// Create record
+	record, err := ctrl.bindingsSvc.Create(ctx, request.Exchange, request.Queue, request.RoutingKey, rules)
+	if err != nil {
+		return nil, errors.Wrap(err, "create failed")
+	}
+
+	// Return response
+	response = &proto.ExchangeBindingsCmds_Create_Response{
+		Record: marshalExchangeBinding(record),
+	}
+
+	return
+}
+
+// Read returns binding by its id.
+func (ctrl *ExchangeBindings) Read(ctx context.Context, request *proto.ExchangeBindingsCmds_Read_Request) (response *proto.ExchangeBindingsCmds_Read_Response, err error) {
+
+	// Fetch record
+	record, err := ctrl.bindingsSvc.Read(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "read failed")
+	}
+
+	// Return response
+	response = &proto.ExchangeBindingsCmds_Read_Response{
+		Record: marshalExchangeBinding(record),
+	}
+
+	return
+}
+
+// Delete removes binding with given ID.
+func (ctrl *ExchangeBindings) Delete(ctx context.Context, request *proto.ExchangeBindingsCmds_Delete_Request) (response *proto.ExchangeBindingsCmds_Delete_Response, err error) {
+
+	response = &proto.ExchangeBindingsCmds_Delete_Response{}
+
+	// Delete record
+	err = ctrl.bindingsSvc.Delete(ctx, request.Id)
+	if err == nil {
+		response.Result = true
+	}
+
+	return response, errors.Wrap(err, "delete failed")
+}
+
+// marshalExchangeBinding is a helper function that marshals domain model of the exchange binding into GRCP model.
+func marshalExchangeBinding(input *models.ExchangeBinding) (output *proto.ExchangeBinding) {
+
+	if input == nil {
+		return nil
+	}
+
+	output = &proto.ExchangeBinding{
+		Id:         input.Id,
+		ExchangeId: input.ExchangeId,
+		QueueId:    input.QueueId,
+		CreatedAt:  marshalTime(input.CreatedAt),
+		RoutingKey: input.RoutingKey,
+	}
+	for _, rule := range input.Rules {
+		output.Rules = append(output.Rules, &proto.ExchangeBinding_Rule{
+			Key:      rule.Key,
+			Operator: proto.ExchangeBinding_Rule_Operator(rule.Operator),
+			Value:    rule.Value,
+		})
+	}
+
+	return
+}
+
+// unmarshalExchangeBindingRule is a helper function that unmarshals GRPC model of the exchange binding rule
+// into domain model.
+func unmarshalExchangeBindingRule(input *proto.ExchangeBinding_Rule) (output *models.ExchangeBindingRule) {
+
+	if input == nil {
+		return nil
+	}
+
+	return &models.ExchangeBindingRule{
+		Key:      input.Key,
+		Operator: models.ExchangeBindingRuleOperator(input.Operator),
+		Value:    input.Value,
+	}
+}
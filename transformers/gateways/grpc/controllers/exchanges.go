@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"github.com/gork-io/gork/models"
+	"github.com/gork-io/gork/services/resources"
+	"github.com/gork-io/gork/transformers/gateways/grpc/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// NewExchanges creates a new instance of Exchanges.
+func NewExchanges(exchangesSvc *resources.Exchanges) (ctrl *Exchanges) {
+	return &Exchanges{
+		exchangesSvc: exchangesSvc,
+	}
+}
+
+// Exchanges controller is a proxy that links GRPC gateway with service layer.
+type Exchanges struct {
+	exchangesSvc *resources.Exchanges // exchanges service
+}
+
+// Register registers this controller as a GRPC service implementation.
+func (ctrl *Exchanges) Register(server *grpc.Server) {
+	proto.RegisterExchangesServer(server, ctrl)
+}
+
+// List returns a subset of the exchanges, based on collection params given.
+func (ctrl *Exchanges) List(ctx context.Context, request *proto.ExchangesCmds_List_Request) (response *proto.ExchangesCmds_List_Response, err error) {
+
+	// Fetch records
+	records, info, err := ctrl.exchangesSvc.List(ctx, unmarshalCollectionParams(request.Params))
+	if err != nil {
+		return nil, errors.Wrap(err, "list failed")
+	}
+
+	// Return response
+	response = &proto.ExchangesCmds_List_Response{
+		Info: marshalCollectionInfo(info),
+	}
+	for _, record := range records {
+		response.Records = append(response.Records, marshalExchange(record))
+	}
+
+	return
+}
+
+// Create creates a new exchange.
+func (ctrl *Exchanges) Create(ctx context.Context, request *proto.ExchangesCmds_Create_Request) (response *proto.ExchangesCmds_Create_Response, err error) {
+
+	// Create record
+	record, err := ctrl.exchangesSvc.Create(ctx, request.Name, models.ExchangeType(request.Type))
+	if err != nil {
+		return nil, errors.Wrap(err, "create failed")
+	}
+
+	// Return response
+	response = &proto.ExchangesCmds_Create_Response{
+		Record: marshalExchange(record),
+	}
+
+	return
+}
+
+// Read returns exchange by its id.
+func (ctrl *Exchanges) Read(ctx context.Context, request *proto.ExchangesCmds_Read_Request) (response *proto.ExchangesCmds_Read_Response, err error) {
+
+	// Fetch record
+	record, err := ctrl.exchangesSvc.Read(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "read failed")
+	}
+
+	// Return response
+	response = &proto.ExchangesCmds_Read_Response{
+		Record: marshalExchange(record),
+	}
+
+	return
+}
+
+// Delete removes exchange with given ID.
+func (ctrl *Exchanges) Delete(ctx context.Context, request *proto.ExchangesCmds_Delete_Request) (response *proto.ExchangesCmds_Delete_Response, err error) {
+
+	response = &proto.ExchangesCmds_Delete_Response{}
+
+	// Delete record
+	err = ctrl.exchangesSvc.Delete(ctx, request.Id)
+	if err == nil {
+		response.Result = true
+	}
+
+	return response, errors.Wrap(err, "delete failed")
+}
+
+// marshalExchange is a helper function that marshals domain model of the exchange into GRCP model.
+func marshalExchange(input *models.Exchange) (output *proto.Exchange) {
+
+	if input == nil {
+		return nil
+	}
+
+	return &proto.Exchange{
+		Id:        input.Id,
+		Name:      input.Name,
+		CreatedAt: marshalTime(input.CreatedAt),
+		Type:      proto.Exchange_Type(input.Type),
+	}
+}
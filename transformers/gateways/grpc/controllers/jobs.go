@@ -0,0 +1,276 @@
+package controllers
+
+import (
+	"io"
+	"time"
+
+	"github.com/gork-io/gork/models"
+	"github.com/gork-io/gork/services/resources"
+	"github.com/gork-io/gork/transformers/gateways/grpc/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	jobsConsumePollInterval = 100 * time.Millisecond // delay between dequeue attempts when queues are empty
+	jobsTailPollInterval    = 500 * time.Millisecond // delay between job updates checks
+)
+
+// NewJobs creates a new instance of Jobs.
+func NewJobs(jobsSvc *resources.Jobs) (ctrl *Jobs) {
+	return &Jobs{
+		jobsSvc: jobsSvc,
+	}
+}
+
+// Jobs controller is a proxy that links GRPC gateway with service layer.
+type Jobs struct {
+	jobsSvc *resources.Jobs // jobs service
+}
+
+// Register registers this controller as a GRPC service implementation.
+func (ctrl *Jobs) Register(server *grpc.Server) {
+	proto.RegisterJobsServer(server, ctrl)
+}
+
+// Consume delivers jobs from the subscribed queues to the worker and processes worker acknowledgements.
+// Commands that fail are reported back to the worker, the stream is only closed on transport errors
+// or if the subscription fails.
+func (ctrl *Jobs) Consume(stream proto.Jobs_ConsumeServer) (err error) {
+
+	ctx := stream.Context()
+
+	// Receive worker commands in background
+	requestsChan := make(chan *proto.JobsCmds_Consume_Request)
+	errsChan := make(chan error, 1)
+	go func() {
+		for {
+			request, err := stream.Recv()
+			if err != nil {
+				errsChan <- err
+				return
+			}
+			select {
+			case requestsChan <- request:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		queueIds []string                    // IDs of the subscribed queues
+		workerId string                      // ID of the registered worker, if any
+		prefetch int                         // max number of unacknowledged jobs
+		inFlight = make(map[string]struct{}) // IDs of the delivered but not yet acknowledged jobs
+	)
+
+	// Put unacknowledged jobs back to the queues once the worker is gone
+	defer func() {
+		for id := range inFlight {
+			ctrl.jobsSvc.Release(context.Background(), id)
+		}
+	}()
+
+	for {
+
+		// Deliver next job if the worker has free capacity
+		wait := jobsConsumePollInterval
+		if len(queueIds) > 0 && len(inFlight) < prefetch {
+			job, err := ctrl.jobsSvc.Next(ctx, queueIds, workerId)
+			if err != nil {
+				return errors.Wrap(err, "next failed")
+			}
+			if job != nil {
+				inFlight[job.Id] = struct{}{}
+				err = stream.Send(&proto.JobsCmds_Consume_Response{
+					Job: marshalJob(job),
+				})
+				if err != nil {
+					return errors.Wrap(err, "send failed")
+				}
+
+				// Rotate queues, so all of them are served fairly
+				queueIds = append(queueIds[1:], queueIds[0])
+				wait = 0
+			}
+		}
+
+		// Wait for the worker command or the next delivery attempt
+		select {
+		case request := <-requestsChan:
+			var jobId string // ID of the job the command refers to
+			switch command := request.Command.(type) {
+			case *proto.JobsCmds_Consume_Request_Subscribe:
+				queueIds, err = ctrl.jobsSvc.Subscribe(ctx, command.Subscribe.Queues, command.Subscribe.WorkerId)
+				if err != nil {
+					return errors.Wrap(err, "subscribe failed")
+				}
+				workerId = command.Subscribe.WorkerId
+				prefetch = int(command.Subscribe.Prefetch)
+				if prefetch == 0 {
+					prefetch = 1
+				}
+			case *proto.JobsCmds_Consume_Request_Ack:
+				jobId = command.Ack.JobId
+				if err = ctrl.release(inFlight, jobId); err == nil {
+					err = errors.Wrap(ctrl.jobsSvc.Ack(ctx, jobId, command.Ack.Output), "ack failed")
+				}
+			case *proto.JobsCmds_Consume_Request_Nack:
+				jobId = command.Nack.JobId
+				if err = ctrl.release(inFlight, jobId); err == nil {
+					err = errors.Wrap(ctrl.jobsSvc.Nack(ctx, jobId, command.Nack.Error), "nack failed")
+				}
+			case *proto.JobsCmds_Consume_Request_Reject:
+				jobId = command.Reject.JobId
+				if err = ctrl.release(inFlight, jobId); err == nil {
+					err = errors.Wrap(ctrl.jobsSvc.Reject(ctx, jobId, command.Reject.Error), "reject failed")
+				}
+			case *proto.JobsCmds_Consume_Request_Progress:
+				jobId = command.Progress.JobId
+				if err = ctrl.hold(inFlight, jobId); err == nil {
+					err = errors.Wrap(ctrl.jobsSvc.Progress(ctx, jobId, command.Progress.Progress), "progress failed")
+				}
+			case *proto.JobsCmds_Consume_Request_Log:
+				jobId = command.Log.JobId
+				if err = ctrl.hold(inFlight, jobId); err == nil {
+					err = errors.Wrap(ctrl.jobsSvc.Log(ctx, jobId, command.Log.Lines), "log failed")
+				}
+			case *proto.JobsCmds_Consume_Request_Touch:
+				jobId = command.Touch.JobId
+				if err = ctrl.hold(inFlight, jobId); err == nil {
+					err = errors.Wrap(ctrl.jobsSvc.Touch(ctx, jobId), "touch failed")
+				}
+			default:
+				err = errors.New("unknown command")
+			}
+
+			// Report failed command back to the worker, the stream is kept open
+			if err != nil {
+				err = stream.Send(&proto.JobsCmds_Consume_Response{
+					Failure: &proto.JobsCmds_Consume_Failure{
+						JobId: jobId,
+						Error: err.Error(),
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "send failed")
+				}
+			}
+		case err = <-errsChan:
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrap(err, "receive failed")
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Read returns job by its ID.
+func (ctrl *Jobs) Read(ctx context.Context, request *proto.JobsCmds_Read_Request) (response *proto.JobsCmds_Read_Response, err error) {
+
+	// Fetch record
+	record, err := ctrl.jobsSvc.Read(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "read failed")
+	}
+
+	// Return response
+	response = &proto.JobsCmds_Read_Response{
+		Record: marshalJob(record),
+	}
+
+	return
+}
+
+// Tail streams progress and log lines of the job with given ID until the job is finished.
+func (ctrl *Jobs) Tail(request *proto.JobsCmds_Tail_Request, stream proto.Jobs_TailServer) (err error) {
+
+	ctx := stream.Context()
+
+	var (
+		offset   int  // number of the log lines already sent
+		progress = -1 // progress already sent
+	)
+
+	for {
+
+		// Fetch updates
+		record, lines, err := ctrl.jobsSvc.Tail(ctx, request.Id, offset)
+		if err != nil {
+			return errors.Wrap(err, "tail failed")
+		}
+		finished := !record.FinishedAt.IsZero()
+
+		// Send updates, if any
+		if len(lines) > 0 || int(record.Progress) != progress || finished {
+			err = stream.Send(&proto.JobsCmds_Tail_Response{
+				Progress: record.Progress,
+				Lines:    lines,
+				Finished: finished,
+			})
+			if err != nil {
+				return errors.Wrap(err, "send failed")
+			}
+			offset += len(lines)
+			progress = int(record.Progress)
+		}
+		if finished {
+			return nil
+		}
+
+		// Wait for the next check
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(jobsTailPollInterval):
+		}
+	}
+}
+
+// hold is a helper function that checks that job with given ID is in the list of jobs in flight.
+func (ctrl *Jobs) hold(inFlight map[string]struct{}, id string) (err error) {
+
+	if _, ok := inFlight[id]; !ok {
+		return errors.New("job is not delivered to this worker")
+	}
+
+	return
+}
+
+// release is a helper function that removes job with given ID from the list of jobs in flight.
+func (ctrl *Jobs) release(inFlight map[string]struct{}, id string) (err error) {
+
+	if err = ctrl.hold(inFlight, id); err != nil {
+		return
+	}
+	delete(inFlight, id)
+
+	return
+}
+
+// marshalJob is a helper function that marshals domain model of the job into GRCP model.
+func marshalJob(input *models.Job) (output *proto.Job) {
+
+	if input == nil {
+		return nil
+	}
+
+	return &proto.Job{
+		Id:          input.Id,
+		TaskId:      input.TaskId,
+		QueueId:     input.QueueId,
+		Headers:     marshalHeaders(input.Headers),
+		Input:       input.Input,
+		Progress:    input.Progress,
+		Logs:        input.Logs,
+		CreatedAt:   marshalTime(input.CreatedAt),
+		DeliveredAt: marshalTime(input.DeliveredAt),
+		FinishedAt:  marshalTime(input.FinishedAt),
+		WorkerId:    input.WorkerId,
+	}
+}
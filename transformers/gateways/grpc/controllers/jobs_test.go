@@ -0,0 +1,217 @@
+package controllers
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	"github.com/gork-io/gork/services/resources"
+	"github.com/gork-io/gork/transformers/gateways/grpc/proto"
+	redis_repo "github.com/gork-io/gork/transformers/repositories/redis"
+	"golang.org/x/net/context"
+)
+
+// testConsumeStream is an in-memory implementation of the Consume stream, driven by the test.
+type testConsumeStream struct {
+	proto.Jobs_ConsumeServer
+	ctx       context.Context
+	requests  chan *proto.JobsCmds_Consume_Request
+	responses chan *proto.JobsCmds_Consume_Response
+}
+
+func (stream *testConsumeStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *testConsumeStream) Send(response *proto.JobsCmds_Consume_Response) error {
+	stream.responses <- response
+	return nil
+}
+
+func (stream *testConsumeStream) Recv() (*proto.JobsCmds_Consume_Request, error) {
+	request, ok := <-stream.requests
+	if !ok {
+		return nil, io.EOF
+	}
+	return request, nil
+}
+
+// testJobsEnv holds the jobs controller along with the services and repositories it is backed by.
+type testJobsEnv struct {
+	server    *miniredis.Miniredis
+	ctrl      *Jobs
+	queuesSvc *resources.Queues
+	tasksSvc  *resources.Tasks
+	tasksRepo *redis_repo.TasksRepository
+}
+
+// newTestJobsEnv creates jobs controller backed by in-memory redis server.
+func newTestJobsEnv(t *testing.T) (env *testJobsEnv) {
+
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start redis server: %v", err)
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	queuesRepo := redis_repo.NewQueuesRepository(client)
+	tasksRepo := redis_repo.NewTasksRepository(client)
+	exchangesRepo := redis_repo.NewExchangesRepository(client)
+	bindingsRepo := redis_repo.NewExchangeBindingsRepository(client)
+	jobsRepo := redis_repo.NewJobsRepository(client)
+	workersRepo := redis_repo.NewWorkersRepository(client)
+
+	return &testJobsEnv{
+		server:    server,
+		ctrl:      NewJobs(resources.NewJobs(jobsRepo, tasksRepo, queuesRepo, workersRepo)),
+		queuesSvc: resources.NewQueues(queuesRepo, tasksRepo),
+		tasksSvc:  resources.NewTasks(tasksRepo, queuesRepo, exchangesRepo, bindingsRepo, resources.NewExchangeRouters()),
+		tasksRepo: tasksRepo,
+	}
+}
+
+// consume runs Consume on a new test stream in background. Consume result is sent to the returned channel.
+func (env *testJobsEnv) consume(ctx context.Context) (stream *testConsumeStream, result chan error) {
+
+	stream = &testConsumeStream{
+		ctx:       ctx,
+		requests:  make(chan *proto.JobsCmds_Consume_Request, 10),
+		responses: make(chan *proto.JobsCmds_Consume_Response, 10),
+	}
+	result = make(chan error, 1)
+	go func() {
+		result <- env.ctrl.Consume(stream)
+	}()
+
+	return
+}
+
+// receive waits for the next response of the stream.
+func (stream *testConsumeStream) receive(t *testing.T) (response *proto.JobsCmds_Consume_Response) {
+
+	select {
+	case response = <-stream.responses:
+		return response
+	case <-time.After(2 * time.Second):
+		t.Fatal("no response received")
+	}
+	return
+}
+
+// waitTaskStatus waits until the task with given ID has given status.
+func (env *testJobsEnv) waitTaskStatus(t *testing.T, id string, status models.TaskStatus) (task *models.Task) {
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		task, err := env.tasksRepo.GetById(context.Background(), id)
+		if err != nil {
+			t.Fatalf("failed to retrieve task: %v", err)
+		}
+		if task != nil && task.Status == status {
+			return task
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("task %s: got %+v, want status %d", id, task, status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestJobsConsume(t *testing.T) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	env := newTestJobsEnv(t)
+	defer env.server.Close()
+
+	if _, err := env.queuesSvc.Create(ctx, "q", nil); err != nil {
+		t.Fatalf("failed to create queue: %v", err)
+	}
+	stream, result := env.consume(ctx)
+	stream.requests <- &proto.JobsCmds_Consume_Request{Command: &proto.JobsCmds_Consume_Request_Subscribe{
+		Subscribe: &proto.JobsCmds_Consume_Subscribe{Queues: []string{"q"}},
+	}}
+
+	// Acknowledged task is finished with the output
+	acked, err := env.tasksSvc.Publish(ctx, "q", 0, nil, []byte("input"), models.PublishOptions{})
+	if err != nil {
+		t.Fatalf("failed to publish task: %v", err)
+	}
+	job := stream.receive(t).Job
+	if job == nil || job.TaskId != acked.Id || string(job.Input) != "input" {
+		t.Fatalf("Consume() delivered %+v, want job of task %s", job, acked.Id)
+	}
+	stream.requests <- &proto.JobsCmds_Consume_Request{Command: &proto.JobsCmds_Consume_Request_Ack{
+		Ack: &proto.JobsCmds_Consume_Ack{JobId: job.Id, Output: []byte("output")},
+	}}
+	if task := env.waitTaskStatus(t, acked.Id, models.TaskStatusFinished); string(task.Output) != "output" {
+		t.Fatalf("acknowledged task output = %q, want output", task.Output)
+	}
+
+	// Settling the job again is reported back, the stream is kept open
+	stream.requests <- &proto.JobsCmds_Consume_Request{Command: &proto.JobsCmds_Consume_Request_Ack{
+		Ack: &proto.JobsCmds_Consume_Ack{JobId: job.Id},
+	}}
+	if failure := stream.receive(t).Failure; failure == nil || failure.JobId != job.Id || failure.Error == "" {
+		t.Fatalf("Consume() reported %+v, want failure of job %s", failure, job.Id)
+	}
+
+	// Negatively acknowledged task is delivered again, rejected one is failed for good
+	nacked, err := env.tasksSvc.Publish(ctx, "q", 0, nil, nil, models.PublishOptions{})
+	if err != nil {
+		t.Fatalf("failed to publish task: %v", err)
+	}
+	job = stream.receive(t).Job
+	stream.requests <- &proto.JobsCmds_Consume_Request{Command: &proto.JobsCmds_Consume_Request_Nack{
+		Nack: &proto.JobsCmds_Consume_Nack{JobId: job.Id, Error: "temporary"},
+	}}
+	retried := stream.receive(t).Job
+	if retried == nil || retried.TaskId != nacked.Id || retried.Id == job.Id {
+		t.Fatalf("Consume() delivered %+v, want new job of task %s", retried, nacked.Id)
+	}
+	stream.requests <- &proto.JobsCmds_Consume_Request{Command: &proto.JobsCmds_Consume_Request_Reject{
+		Reject: &proto.JobsCmds_Consume_Reject{JobId: retried.Id, Error: "permanent"},
+	}}
+	task := env.waitTaskStatus(t, nacked.Id, models.TaskStatusFailed)
+	if task.Attempts != 2 || task.LastError != "permanent" {
+		t.Fatalf("rejected task = %+v, want 2 attempts and last error", task)
+	}
+
+	// Job that is in flight when the worker is gone is put back to the queue
+	released, err := env.tasksSvc.Publish(ctx, "q", 0, nil, nil, models.PublishOptions{})
+	if err != nil {
+		t.Fatalf("failed to publish task: %v", err)
+	}
+	if job = stream.receive(t).Job; job.TaskId != released.Id {
+		t.Fatalf("Consume() delivered %+v, want job of task %s", job, released.Id)
+	}
+	close(stream.requests)
+	if err := <-result; err != nil {
+		t.Fatalf("Consume() = %v, want nil on end of stream", err)
+	}
+	env.waitTaskStatus(t, released.Id, models.TaskStatusPending)
+}
+
+func TestJobsConsumeInvalidSubscribe(t *testing.T) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	env := newTestJobsEnv(t)
+	defer env.server.Close()
+
+	stream, result := env.consume(ctx)
+	stream.requests <- &proto.JobsCmds_Consume_Request{Command: &proto.JobsCmds_Consume_Request_Subscribe{
+		Subscribe: &proto.JobsCmds_Consume_Subscribe{Queues: []string{"missing"}},
+	}}
+	select {
+	case err := <-result:
+		if err == nil {
+			t.Fatal("Consume() = nil, want subscription error")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Consume() kept the stream open after invalid subscription")
+	}
+}
@@ -32,10 +32,7 @@ func (ctrl *Queues) Register(server *grpc.Server) {
 func (ctrl *Queues) List(ctx context.Context, request *proto.QueuesCmds_List_Request) (response *proto.QueuesCmds_List_Response, err error) {
 
 	// Fetch records
-	records, info, err := ctrl.queuesSvc.List(ctx, models.NewCollectionParams(
-		request.Params.Cursor,
-		request.Params.Limit,
-	))
+	records, info, err := ctrl.queuesSvc.List(ctx, unmarshalCollectionParams(request.Params))
 	if err != nil {
 		return nil, errors.Wrap(err, "list failed")
 	}
@@ -74,6 +71,29 @@ func (ctrl *Queues) Create(ctx context.Context, request *proto.QueuesCmds_Create
 	return
 }
 
+// Update overwrites given settings of the queue with given ID, if the queue is still at given version.
+func (ctrl *Queues) Update(ctx context.Context, request *proto.QueuesCmds_Update_Request) (response *proto.QueuesCmds_Update_Response, err error) {
+
+	// Convert settings
+	settings := make(map[models.QueueSetting]string)
+	for _, setting := range request.Settings {
+		settings[models.QueueSetting(setting.Key)] = setting.Value
+	}
+
+	// Update record
+	record, err := ctrl.queuesSvc.Update(ctx, request.Id, settings, request.Version)
+	if err != nil {
+		return nil, errors.Wrap(err, "update failed")
+	}
+
+	// Return response
+	response = &proto.QueuesCmds_Update_Response{
+		Record: marshalQueue(record),
+	}
+
+	return
+}
+
 // Read returns query by its id.
 func (ctrl *Queues) Read(ctx context.Context, request *proto.QueuesCmds_Read_Request) (response *proto.QueuesCmds_Read_Response, err error) {
 
@@ -105,6 +125,86 @@ func (ctrl *Queues) Delete(ctx context.Context, request *proto.QueuesCmds_Delete
 	return response, errors.Wrap(err, "delete failed")
 }
 
+// Pause stops delivery of the tasks of the queue with given ID.
+func (ctrl *Queues) Pause(ctx context.Context, request *proto.QueuesCmds_Pause_Request) (response *proto.QueuesCmds_Pause_Response, err error) {
+
+	// Update record
+	record, err := ctrl.queuesSvc.Pause(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "pause failed")
+	}
+
+	// Return response
+	response = &proto.QueuesCmds_Pause_Response{
+		Record: marshalQueue(record),
+	}
+
+	return
+}
+
+// Resume restarts delivery of the tasks of the queue with given ID.
+func (ctrl *Queues) Resume(ctx context.Context, request *proto.QueuesCmds_Resume_Request) (response *proto.QueuesCmds_Resume_Response, err error) {
+
+	// Update record
+	record, err := ctrl.queuesSvc.Resume(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "resume failed")
+	}
+
+	// Return response
+	response = &proto.QueuesCmds_Resume_Response{
+		Record: marshalQueue(record),
+	}
+
+	return
+}
+
+// Purge deletes pending tasks of the queue with given ID, optionally along with its delayed
+// and dead-lettered tasks.
+func (ctrl *Queues) Purge(ctx context.Context, request *proto.QueuesCmds_Purge_Request) (response *proto.QueuesCmds_Purge_Response, err error) {
+
+	// Delete records
+	count, err := ctrl.queuesSvc.Purge(ctx, request.Id, request.Delayed, request.DeadLettered)
+	if err != nil {
+		return nil, errors.Wrap(err, "purge failed")
+	}
+
+	// Return response
+	response = &proto.QueuesCmds_Purge_Response{
+		Count: uint64(count),
+	}
+
+	return
+}
+
+// Stats returns live statistics of the queue with given ID.
+func (ctrl *Queues) Stats(ctx context.Context, request *proto.QueuesCmds_Stats_Request) (response *proto.QueuesCmds_Stats_Response, err error) {
+
+	// Fetch stats
+	stats, err := ctrl.queuesSvc.Stats(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "stats failed")
+	}
+
+	// Return response
+	response = &proto.QueuesCmds_Stats_Response{
+		Pending:          stats.Pending,
+		Delayed:          stats.Delayed,
+		Processing:       stats.Processing,
+		Finished:         stats.Finished,
+		Failed:           stats.Failed,
+		Expired:          stats.Expired,
+		DeadLettered:     stats.DeadLettered,
+		Enqueued:         stats.Enqueued,
+		Dequeued:         stats.Dequeued,
+		EnqueueRate:      stats.EnqueueRate,
+		DequeueRate:      stats.DequeueRate,
+		OldestPendingAge: stats.OldestPendingAge.Seconds(),
+	}
+
+	return
+}
+
 // marshalQueue is a helper function that marshals domain model of the queue into GRCP model.
 func marshalQueue(input *models.Queue) (output *proto.Queue) {
 
@@ -115,6 +215,8 @@ func marshalQueue(input *models.Queue) (output *proto.Queue) {
 	output = &proto.Queue{
 		Id:        input.Id,
 		Name:      input.Name,
+		Paused:    input.Paused,
+		Version:   input.Version,
 		CreatedAt: input.CreatedAt.Format(time.RFC3339Nano),
 	}
 	for key, value := range input.Settings {
@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"github.com/gork-io/gork/models"
+	"github.com/gork-io/gork/services/resources"
+	"github.com/gork-io/gork/transformers/gateways/grpc/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// NewSchedules creates a new instance of Schedules.
+func NewSchedules(schedulesSvc *resources.Schedules) (ctrl *Schedules) {
+	return &Schedules{
+		schedulesSvc: schedulesSvc,
+	}
+}
+
+// Schedules controller is a proxy that links GRPC gateway with service layer.
+type Schedules struct {
+	schedulesSvc *resources.Schedules // schedules service
+}
+
+// Register registers this controller as a GRPC service implementation.
+func (ctrl *Schedules) Register(server *grpc.Server) {
+	proto.RegisterSchedulesServer(server, ctrl)
+}
+
+// List returns a subset of the schedules, based on collection params given.
+func (ctrl *Schedules) List(ctx context.Context, request *proto.SchedulesCmds_List_Request) (response *proto.SchedulesCmds_List_Response, err error) {
+
+	// Fetch records
+	records, info, err := ctrl.schedulesSvc.List(ctx, unmarshalCollectionParams(request.Params))
+	if err != nil {
+		return nil, errors.Wrap(err, "list failed")
+	}
+
+	// Return response
+	response = &proto.SchedulesCmds_List_Response{
+		Info: marshalCollectionInfo(info),
+	}
+	for _, record := range records {
+		response.Records = append(response.Records, marshalSchedule(record))
+	}
+
+	return
+}
+
+// Create creates a new schedule.
+func (ctrl *Schedules) Create(ctx context.Context, request *proto.SchedulesCmds_Create_Request) (response *proto.SchedulesCmds_Create_Response, err error) {
+
+	// Create record
+	record, err := ctrl.schedulesSvc.Create(
+		ctx,
+		request.Queue,
+		request.Expression,
+		request.Priority,
+		unmarshalHeaders(request.Headers),
+		request.Input,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "create failed")
+	}
+
+	// Return response
+	response = &proto.SchedulesCmds_Create_Response{
+		Record: marshalSchedule(record),
+	}
+
+	return
+}
+
+// Read returns schedule by its id.
+func (ctrl *Schedules) Read(ctx context.Context, request *proto.SchedulesCmds_Read_Request) (response *proto.SchedulesCmds_Read_Response, err error) {
+
+	// Fetch record
+	record, err := ctrl.schedulesSvc.Read(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "read failed")
+	}
+
+	// Return response
+	response = &proto.SchedulesCmds_Read_Response{
+		Record: marshalSchedule(record),
+	}
+
+	return
+}
+
+// Update replaces cron expression and task template of the schedule.
+func (ctrl *Schedules) Update(ctx context.Context, request *proto.SchedulesCmds_Update_Request) (response *proto.SchedulesCmds_Update_Response, err error) {
+
+	// Update record
+	record, err := ctrl.schedulesSvc.Update(
+		ctx,
+		request.Id,
+		request.Expression,
+		request.Priority,
+		unmarshalHeaders(request.Headers),
+		request.Input,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "update failed")
+	}
+
+	// Return response
+	response = &proto.SchedulesCmds_Update_Response{
+		Record: marshalSchedule(record),
+	}
+
+	return
+}
+
+// Delete removes schedule with given ID.
+func (ctrl *Schedules) Delete(ctx context.Context, request *proto.SchedulesCmds_Delete_Request) (response *proto.SchedulesCmds_Delete_Response, err error) {
+
+	response = &proto.SchedulesCmds_Delete_Response{}
+
+	// Delete record
+	err = ctrl.schedulesSvc.Delete(ctx, request.Id)
+	if err == nil {
+		response.Result = true
+	}
+
+	return response, errors.Wrap(err, "delete failed")
+}
+
+// marshalSchedule is a helper function that marshals domain model of the schedule into GRCP model.
+func marshalSchedule(input *models.Schedule) (output *proto.Schedule) {
+
+	if input == nil {
+		return nil
+	}
+
+	return &proto.Schedule{
+		Id:         input.Id,
+		QueueId:    input.QueueId,
+		Expression: input.Expression,
+		Priority:   input.Priority,
+		Headers:    marshalHeaders(input.Headers),
+		Input:      input.Input,
+		NextRunAt:  marshalTime(input.NextRunAt),
+		CreatedAt:  marshalTime(input.CreatedAt),
+	}
+}
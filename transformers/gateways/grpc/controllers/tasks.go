@@ -0,0 +1,262 @@
+package controllers
+
+import (
+	"time"
+
+	"github.com/gork-io/gork/models"
+	"github.com/gork-io/gork/services/resources"
+	"github.com/gork-io/gork/transformers/gateways/grpc/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	tasksAwaitPollInterval = 200 * time.Millisecond // delay between task result checks
+	tasksAwaitMaxTimeout   = 300 * time.Second      // longest time a single request may wait for the task result
+)
+
+// NewTasks creates a new instance of Tasks.
+func NewTasks(tasksSvc *resources.Tasks) (ctrl *Tasks) {
+	return &Tasks{
+		tasksSvc: tasksSvc,
+	}
+}
+
+// Tasks controller is a proxy that links GRPC gateway with service layer.
+type Tasks struct {
+	tasksSvc *resources.Tasks // tasks service
+}
+
+// Register registers this controller as a GRPC service implementation.
+func (ctrl *Tasks) Register(server *grpc.Server) {
+	proto.RegisterTasksServer(server, ctrl)
+}
+
+// Publish creates a new task and puts it into the queue, or routes its copies to the queues through the exchange.
+func (ctrl *Tasks) Publish(ctx context.Context, request *proto.TasksCmds_Publish_Request) (response *proto.TasksCmds_Publish_Response, err error) {
+
+	// Parse expiration time
+	expiresAt, err := unmarshalTime(request.ExpiresAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse expiration time")
+	}
+
+	// Parse run time, either absolute or relative
+	runAt, err := unmarshalTime(request.RunAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse run time")
+	}
+	if request.Delay > 0 {
+		if !runAt.IsZero() {
+			return nil, errors.New("run time and delay are mutually exclusive")
+		}
+		runAt = time.Now().Add(time.Duration(request.Delay) * time.Second)
+	}
+	options := models.PublishOptions{
+		RunAt:          runAt,
+		ExpiresAt:      expiresAt,
+		IdempotencyKey: request.IdempotencyKey,
+		UniqueKey:      request.UniqueKey,
+	}
+
+	// Create records, if the task is published to the exchange
+	if request.Exchange != "" {
+		if request.Queue != "" {
+			return nil, errors.New("queue and exchange are mutually exclusive")
+		}
+		records, err := ctrl.tasksSvc.PublishToExchange(
+			ctx,
+			request.Exchange,
+			request.RoutingKey,
+			request.Priority,
+			unmarshalHeaders(request.Headers),
+			request.Input,
+			options,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "publish failed")
+		}
+		response = &proto.TasksCmds_Publish_Response{}
+		for _, record := range records {
+			response.Records = append(response.Records, marshalTask(record))
+		}
+		return response, nil
+	}
+
+	// Create record
+	record, err := ctrl.tasksSvc.Publish(
+		ctx,
+		request.Queue,
+		request.Priority,
+		unmarshalHeaders(request.Headers),
+		request.Input,
+		options,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "publish failed")
+	}
+
+	// Return response
+	response = &proto.TasksCmds_Publish_Response{
+		Record: marshalTask(record),
+	}
+
+	return
+}
+
+// Read returns task by its id.
+func (ctrl *Tasks) Read(ctx context.Context, request *proto.TasksCmds_Read_Request) (response *proto.TasksCmds_Read_Response, err error) {
+
+	// Fetch record
+	record, err := ctrl.tasksSvc.Read(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "read failed")
+	}
+
+	// Return response
+	response = &proto.TasksCmds_Read_Response{
+		Record: marshalTask(record),
+	}
+
+	return
+}
+
+// List returns a subset of the tasks of the queue, based on collection params given.
+func (ctrl *Tasks) List(ctx context.Context, request *proto.TasksCmds_List_Request) (response *proto.TasksCmds_List_Response, err error) {
+
+	// Fetch records
+	records, info, err := ctrl.tasksSvc.List(ctx, request.QueueId, unmarshalCollectionParams(request.Params))
+	if err != nil {
+		return nil, errors.Wrap(err, "list failed")
+	}
+
+	// Return response
+	response = &proto.TasksCmds_List_Response{
+		Info: marshalCollectionInfo(info),
+	}
+	for _, record := range records {
+		response.Records = append(response.Records, marshalTask(record))
+	}
+
+	return
+}
+
+// Cancel prevents pending, delayed or waiting task with given ID from being delivered.
+func (ctrl *Tasks) Cancel(ctx context.Context, request *proto.TasksCmds_Cancel_Request) (response *proto.TasksCmds_Cancel_Response, err error) {
+
+	response = &proto.TasksCmds_Cancel_Response{}
+
+	// Cancel record
+	err = ctrl.tasksSvc.Cancel(ctx, request.Id)
+	if err == nil {
+		response.Result = true
+	}
+
+	return response, errors.Wrap(err, "cancel failed")
+}
+
+// CountExpired returns the number of tasks of the queue that expired before delivery.
+func (ctrl *Tasks) CountExpired(ctx context.Context, request *proto.TasksCmds_CountExpired_Request) (response *proto.TasksCmds_CountExpired_Response, err error) {
+
+	// Fetch counter
+	count, err := ctrl.tasksSvc.CountExpired(ctx, request.QueueId)
+	if err != nil {
+		return nil, errors.Wrap(err, "count expired failed")
+	}
+
+	// Return response
+	response = &proto.TasksCmds_CountExpired_Response{
+		Count: count,
+	}
+
+	return
+}
+
+// GetResult returns result of the task, if its processing is over.
+func (ctrl *Tasks) GetResult(ctx context.Context, request *proto.TasksCmds_GetResult_Request) (response *proto.TasksCmds_GetResult_Response, err error) {
+
+	// Fetch record
+	record, ready, err := ctrl.tasksSvc.Result(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "get result failed")
+	}
+
+	// Return response
+	response = &proto.TasksCmds_GetResult_Response{
+		Ready:      ready,
+		Status:     proto.Task_Status(record.Status),
+		Output:     record.Output,
+		LastError:  record.LastError,
+		FinishedAt: marshalTime(record.FinishedAt),
+	}
+
+	return
+}
+
+// AwaitResult waits until processing of the task is over and returns its result.
+// Result is reported as not ready if the timeout passes first.
+func (ctrl *Tasks) AwaitResult(ctx context.Context, request *proto.TasksCmds_AwaitResult_Request) (response *proto.TasksCmds_AwaitResult_Response, err error) {
+
+	timeout := time.Duration(request.Timeout) * time.Second
+	if timeout == 0 || timeout > tasksAwaitMaxTimeout {
+		timeout = tasksAwaitMaxTimeout
+	}
+	deadline := time.After(timeout)
+
+	for {
+
+		// Fetch record
+		record, ready, err := ctrl.tasksSvc.Result(ctx, request.Id)
+		if err != nil {
+			return nil, errors.Wrap(err, "await result failed")
+		}
+		response = &proto.TasksCmds_AwaitResult_Response{
+			Ready:      ready,
+			Status:     proto.Task_Status(record.Status),
+			Output:     record.Output,
+			LastError:  record.LastError,
+			FinishedAt: marshalTime(record.FinishedAt),
+		}
+		if ready {
+			return response, nil
+		}
+
+		// Wait for the next check
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return response, nil
+		case <-time.After(tasksAwaitPollInterval):
+		}
+	}
+}
+
+// marshalTask is a helper function that marshals domain model of the task into GRCP model.
+func marshalTask(input *models.Task) (output *proto.Task) {
+
+	if input == nil {
+		return nil
+	}
+
+	return &proto.Task{
+		Id:         input.Id,
+		QueueId:    input.QueueId,
+		Status:     proto.Task_Status(input.Status),
+		Priority:   input.Priority,
+		Headers:    marshalHeaders(input.Headers),
+		Input:      input.Input,
+		CreatedAt:  marshalTime(input.CreatedAt),
+		ExpiresAt:  marshalTime(input.ExpiresAt),
+		FinishedAt: marshalTime(input.FinishedAt),
+		RunAt:      marshalTime(input.RunAt),
+		Attempts:   input.Attempts,
+		LastError:  input.LastError,
+		JobId:      input.JobId,
+		Output:     input.Output,
+		WorkflowId: input.WorkflowId,
+		BatchId:    input.BatchId,
+		UniqueKey:  input.UniqueKey,
+	}
+}
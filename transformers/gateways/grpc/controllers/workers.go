@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"github.com/gork-io/gork/models"
+	"github.com/gork-io/gork/services/resources"
+	"github.com/gork-io/gork/transformers/gateways/grpc/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// NewWorkers creates a new instance of Workers.
+func NewWorkers(workersSvc *resources.Workers) (ctrl *Workers) {
+	return &Workers{
+		workersSvc: workersSvc,
+	}
+}
+
+// Workers controller is a proxy that links GRPC gateway with service layer.
+type Workers struct {
+	workersSvc *resources.Workers // workers service
+}
+
+// Register registers this controller as a GRPC service implementation.
+func (ctrl *Workers) Register(server *grpc.Server) {
+	proto.RegisterWorkersServer(server, ctrl)
+}
+
+// List returns a subset of the live workers, based on collection params given.
+func (ctrl *Workers) List(ctx context.Context, request *proto.WorkersCmds_List_Request) (response *proto.WorkersCmds_List_Response, err error) {
+
+	// Fetch records
+	records, info, err := ctrl.workersSvc.List(ctx, unmarshalCollectionParams(request.Params))
+	if err != nil {
+		return nil, errors.Wrap(err, "list failed")
+	}
+
+	// Return response
+	response = &proto.WorkersCmds_List_Response{
+		Info: marshalCollectionInfo(info),
+	}
+	for _, record := range records {
+		response.Records = append(response.Records, marshalWorker(record))
+	}
+
+	return
+}
+
+// Create registers a new live worker.
+func (ctrl *Workers) Create(ctx context.Context, request *proto.WorkersCmds_Create_Request) (response *proto.WorkersCmds_Create_Response, err error) {
+
+	// Create record
+	record, err := ctrl.workersSvc.Register(
+		ctx,
+		request.Name,
+		request.Hostname,
+		request.Version,
+		request.Queues,
+		request.Concurrency,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "register failed")
+	}
+
+	// Return response
+	response = &proto.WorkersCmds_Create_Response{
+		Record: marshalWorker(record),
+	}
+
+	return
+}
+
+// Read returns worker by its id.
+func (ctrl *Workers) Read(ctx context.Context, request *proto.WorkersCmds_Read_Request) (response *proto.WorkersCmds_Read_Response, err error) {
+
+	// Fetch record
+	record, err := ctrl.workersSvc.Read(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "read failed")
+	}
+
+	// Return response
+	response = &proto.WorkersCmds_Read_Response{
+		Record: marshalWorker(record),
+	}
+
+	return
+}
+
+// Heartbeat reports that worker with given ID is still alive.
+func (ctrl *Workers) Heartbeat(ctx context.Context, request *proto.WorkersCmds_Heartbeat_Request) (response *proto.WorkersCmds_Heartbeat_Response, err error) {
+
+	response = &proto.WorkersCmds_Heartbeat_Response{}
+
+	// Update record
+	err = ctrl.workersSvc.Heartbeat(ctx, request.Id)
+	if err == nil {
+		response.Result = true
+	}
+
+	return response, errors.Wrap(err, "heartbeat failed")
+}
+
+// marshalWorker is a helper function that marshals domain model of the worker into GRCP model.
+func marshalWorker(input *models.Worker) (output *proto.Worker) {
+
+	if input == nil {
+		return nil
+	}
+
+	return &proto.Worker{
+		Id:          input.Id,
+		Name:        input.Name,
+		Hostname:    input.Hostname,
+		Version:     input.Version,
+		Queues:      input.Queues,
+		Concurrency: input.Concurrency,
+		Status:      proto.Worker_Status(input.Status),
+		JobIds:      input.JobIds,
+		CreatedAt:   marshalTime(input.CreatedAt),
+		HeartbeatAt: marshalTime(input.HeartbeatAt),
+	}
+}
@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"github.com/gork-io/gork/models"
+	"github.com/gork-io/gork/services/resources"
+	"github.com/gork-io/gork/transformers/gateways/grpc/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// NewWorkflows creates a new instance of Workflows.
+func NewWorkflows(workflowsSvc *resources.Workflows) (ctrl *Workflows) {
+	return &Workflows{
+		workflowsSvc: workflowsSvc,
+	}
+}
+
+// Workflows controller is a proxy that links GRPC gateway with service layer.
+type Workflows struct {
+	workflowsSvc *resources.Workflows // workflows service
+}
+
+// Register registers this controller as a GRPC service implementation.
+func (ctrl *Workflows) Register(server *grpc.Server) {
+	proto.RegisterWorkflowsServer(server, ctrl)
+}
+
+// List returns a subset of the workflows, based on collection params given.
+func (ctrl *Workflows) List(ctx context.Context, request *proto.WorkflowsCmds_List_Request) (response *proto.WorkflowsCmds_List_Response, err error) {
+
+	// Fetch records
+	records, info, err := ctrl.workflowsSvc.List(ctx, unmarshalCollectionParams(request.Params))
+	if err != nil {
+		return nil, errors.Wrap(err, "list failed")
+	}
+
+	// Return response
+	response = &proto.WorkflowsCmds_List_Response{
+		Info: marshalCollectionInfo(info),
+	}
+	for _, record := range records {
+		response.Records = append(response.Records, marshalWorkflow(record, nil))
+	}
+
+	return
+}
+
+// Create submits a new workflow of dependent tasks.
+func (ctrl *Workflows) Create(ctx context.Context, request *proto.WorkflowsCmds_Create_Request) (response *proto.WorkflowsCmds_Create_Response, err error) {
+
+	// Create records
+	var specs []*models.WorkflowTaskSpec
+	for _, spec := range request.Tasks {
+		specs = append(specs, unmarshalWorkflowTaskSpec(spec))
+	}
+	record, tasks, err := ctrl.workflowsSvc.Create(ctx, request.Name, specs)
+	if err != nil {
+		return nil, errors.Wrap(err, "create failed")
+	}
+
+	// Return response
+	response = &proto.WorkflowsCmds_Create_Response{
+		Record: marshalWorkflow(record, tasks),
+	}
+
+	return
+}
+
+// Read returns workflow by its id, along with its tasks.
+func (ctrl *Workflows) Read(ctx context.Context, request *proto.WorkflowsCmds_Read_Request) (response *proto.WorkflowsCmds_Read_Response, err error) {
+
+	// Fetch record
+	record, tasks, err := ctrl.workflowsSvc.Read(ctx, request.Id)
+	if err != nil {
+		return nil, errors.Wrap(err, "read failed")
+	}
+
+	// Return response
+	response = &proto.WorkflowsCmds_Read_Response{
+		Record: marshalWorkflow(record, tasks),
+	}
+
+	return
+}
+
+// marshalWorkflow is a helper function that marshals domain model of the workflow and its tasks into GRCP model.
+func marshalWorkflow(input *models.Workflow, tasks []*models.Task) (output *proto.Workflow) {
+
+	if input == nil {
+		return nil
+	}
+
+	output = &proto.Workflow{
+		Id:        input.Id,
+		Name:      input.Name,
+		Status:    proto.Workflow_Status(input.Status),
+		CreatedAt: marshalTime(input.CreatedAt),
+	}
+	for _, node := range input.Nodes {
+		output.Nodes = append(output.Nodes, &proto.Workflow_Node{
+			TaskId:    node.TaskId,
+			Key:       node.Key,
+			ParentIds: node.ParentIds,
+		})
+	}
+	for _, task := range tasks {
+		output.Tasks = append(output.Tasks, marshalTask(task))
+	}
+
+	return
+}
+
+// unmarshalWorkflowTaskSpec is a helper function that unmarshals GRPC model of the workflow task spec
+// into domain model.
+func unmarshalWorkflowTaskSpec(input *proto.WorkflowsCmds_Create_Spec) (output *models.WorkflowTaskSpec) {
+
+	if input == nil {
+		return nil
+	}
+
+	return &models.WorkflowTaskSpec{
+		Key:       input.Key,
+		Queue:     input.Queue,
+		Priority:  input.Priority,
+		Headers:   unmarshalHeaders(input.Headers),
+		Input:     input.Input,
+		DependsOn: input.DependsOn,
+	}
+}
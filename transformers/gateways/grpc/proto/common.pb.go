@@ -12,6 +12,22 @@
 		Collection
 		Queue
 		QueuesCmds
+		Task
+		TasksCmds
+		Job
+		JobsCmds
+		Schedule
+		SchedulesCmds
+		Worker
+		WorkersCmds
+		Exchange
+		ExchangesCmds
+		ExchangeBinding
+		ExchangeBindingsCmds
+		Workflow
+		WorkflowsCmds
+		Batch
+		BatchesCmds
 */
 package proto
 
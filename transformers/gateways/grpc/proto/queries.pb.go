@@ -19,12 +19,172 @@ var _ = proto1.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type Task_Status int32
+
+const (
+	Task_PENDING    Task_Status = 0
+	Task_PROCESSING Task_Status = 1
+	Task_EXPIRED    Task_Status = 2
+	Task_FINISHED   Task_Status = 3
+	Task_CANCELLED  Task_Status = 4
+	Task_FAILED     Task_Status = 5
+	Task_DELAYED    Task_Status = 6
+	Task_WAITING    Task_Status = 7
+)
+
+var Task_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "PROCESSING",
+	2: "EXPIRED",
+	3: "FINISHED",
+	4: "CANCELLED",
+	5: "FAILED",
+	6: "DELAYED",
+	7: "WAITING",
+}
+var Task_Status_value = map[string]int32{
+	"PENDING":    0,
+	"PROCESSING": 1,
+	"EXPIRED":    2,
+	"FINISHED":   3,
+	"CANCELLED":  4,
+	"FAILED":     5,
+	"DELAYED":    6,
+	"WAITING":    7,
+}
+
+func (x Task_Status) String() string {
+	return proto1.EnumName(Task_Status_name, int32(x))
+}
+func (Task_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptorQueries, []int{2, 0} }
+
+type Worker_Status int32
+
+const (
+	Worker_ALIVE Worker_Status = 0
+	Worker_DEAD  Worker_Status = 1
+)
+
+var Worker_Status_name = map[int32]string{
+	0: "ALIVE",
+	1: "DEAD",
+}
+var Worker_Status_value = map[string]int32{
+	"ALIVE": 0,
+	"DEAD":  1,
+}
+
+func (x Worker_Status) String() string {
+	return proto1.EnumName(Worker_Status_name, int32(x))
+}
+func (Worker_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptorQueries, []int{8, 0} }
+
+type Exchange_Type int32
+
+const (
+	Exchange_HEADERS Exchange_Type = 0
+	Exchange_FANOUT  Exchange_Type = 1
+	Exchange_DIRECT  Exchange_Type = 2
+	Exchange_TOPIC   Exchange_Type = 3
+)
+
+var Exchange_Type_name = map[int32]string{
+	0: "HEADERS",
+	1: "FANOUT",
+	2: "DIRECT",
+	3: "TOPIC",
+}
+var Exchange_Type_value = map[string]int32{
+	"HEADERS": 0,
+	"FANOUT":  1,
+	"DIRECT":  2,
+	"TOPIC":   3,
+}
+
+func (x Exchange_Type) String() string {
+	return proto1.EnumName(Exchange_Type_name, int32(x))
+}
+func (Exchange_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptorQueries, []int{10, 0} }
+
+type ExchangeBinding_Rule_Operator int32
+
+const (
+	ExchangeBinding_Rule_EQUALS                 ExchangeBinding_Rule_Operator = 0
+	ExchangeBinding_Rule_NOT_EQUALS             ExchangeBinding_Rule_Operator = 1
+	ExchangeBinding_Rule_PREFIX                 ExchangeBinding_Rule_Operator = 2
+	ExchangeBinding_Rule_REGEX                  ExchangeBinding_Rule_Operator = 3
+	ExchangeBinding_Rule_IN                     ExchangeBinding_Rule_Operator = 4
+	ExchangeBinding_Rule_EXISTS                 ExchangeBinding_Rule_Operator = 5
+	ExchangeBinding_Rule_GREATER_THAN           ExchangeBinding_Rule_Operator = 6
+	ExchangeBinding_Rule_GREATER_THAN_OR_EQUALS ExchangeBinding_Rule_Operator = 7
+	ExchangeBinding_Rule_LESS_THAN              ExchangeBinding_Rule_Operator = 8
+	ExchangeBinding_Rule_LESS_THAN_OR_EQUALS    ExchangeBinding_Rule_Operator = 9
+)
+
+var ExchangeBinding_Rule_Operator_name = map[int32]string{
+	0: "EQUALS",
+	1: "NOT_EQUALS",
+	2: "PREFIX",
+	3: "REGEX",
+	4: "IN",
+	5: "EXISTS",
+	6: "GREATER_THAN",
+	7: "GREATER_THAN_OR_EQUALS",
+	8: "LESS_THAN",
+	9: "LESS_THAN_OR_EQUALS",
+}
+var ExchangeBinding_Rule_Operator_value = map[string]int32{
+	"EQUALS":                 0,
+	"NOT_EQUALS":             1,
+	"PREFIX":                 2,
+	"REGEX":                  3,
+	"IN":                     4,
+	"EXISTS":                 5,
+	"GREATER_THAN":           6,
+	"GREATER_THAN_OR_EQUALS": 7,
+	"LESS_THAN":              8,
+	"LESS_THAN_OR_EQUALS":    9,
+}
+
+func (x ExchangeBinding_Rule_Operator) String() string {
+	return proto1.EnumName(ExchangeBinding_Rule_Operator_name, int32(x))
+}
+func (ExchangeBinding_Rule_Operator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{12, 0, 0}
+}
+
+type Workflow_Status int32
+
+const (
+	Workflow_RUNNING  Workflow_Status = 0
+	Workflow_FINISHED Workflow_Status = 1
+	Workflow_FAILED   Workflow_Status = 2
+)
+
+var Workflow_Status_name = map[int32]string{
+	0: "RUNNING",
+	1: "FINISHED",
+	2: "FAILED",
+}
+var Workflow_Status_value = map[string]int32{
+	"RUNNING":  0,
+	"FINISHED": 1,
+	"FAILED":   2,
+}
+
+func (x Workflow_Status) String() string {
+	return proto1.EnumName(Workflow_Status_name, int32(x))
+}
+func (Workflow_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptorQueries, []int{14, 0} }
+
 // Queue represents a single queue.
 type Queue struct {
 	Id        string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name      string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Settings  []*Queue_Setting `protobuf:"bytes,3,rep,name=settings" json:"settings,omitempty"`
 	CreatedAt string           `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Paused    bool             `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	Version   int64            `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *Queue) Reset()                    { *m = Queue{} }
@@ -143,13 +303,45 @@ func (*QueuesCmds_Read_Response) Descriptor() ([]byte, []int) {
 	return fileDescriptorQueries, []int{1, 2, 1}
 }
 
+type QueuesCmds_Update struct {
+}
+
+func (m *QueuesCmds_Update) Reset()                    { *m = QueuesCmds_Update{} }
+func (m *QueuesCmds_Update) String() string            { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Update) ProtoMessage()               {}
+func (*QueuesCmds_Update) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{1, 3} }
+
+type QueuesCmds_Update_Request struct {
+	Id       string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Settings []*Queue_Setting `protobuf:"bytes,2,rep,name=settings" json:"settings,omitempty"`
+	Version  int64            `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueuesCmds_Update_Request) Reset()         { *m = QueuesCmds_Update_Request{} }
+func (m *QueuesCmds_Update_Request) String() string { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Update_Request) ProtoMessage()    {}
+func (*QueuesCmds_Update_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{1, 3, 0}
+}
+
+type QueuesCmds_Update_Response struct {
+	Record *Queue `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *QueuesCmds_Update_Response) Reset()         { *m = QueuesCmds_Update_Response{} }
+func (m *QueuesCmds_Update_Response) String() string { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Update_Response) ProtoMessage()    {}
+func (*QueuesCmds_Update_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{1, 3, 1}
+}
+
 type QueuesCmds_Delete struct {
 }
 
 func (m *QueuesCmds_Delete) Reset()                    { *m = QueuesCmds_Delete{} }
 func (m *QueuesCmds_Delete) String() string            { return proto1.CompactTextString(m) }
 func (*QueuesCmds_Delete) ProtoMessage()               {}
-func (*QueuesCmds_Delete) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{1, 3} }
+func (*QueuesCmds_Delete) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{1, 4} }
 
 type QueuesCmds_Delete_Request struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -159,7 +351,7 @@ func (m *QueuesCmds_Delete_Request) Reset()         { *m = QueuesCmds_Delete_Req
 func (m *QueuesCmds_Delete_Request) String() string { return proto1.CompactTextString(m) }
 func (*QueuesCmds_Delete_Request) ProtoMessage()    {}
 func (*QueuesCmds_Delete_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptorQueries, []int{1, 3, 0}
+	return fileDescriptorQueries, []int{1, 4, 0}
 }
 
 type QueuesCmds_Delete_Response struct {
@@ -170,802 +362,25010 @@ func (m *QueuesCmds_Delete_Response) Reset()         { *m = QueuesCmds_Delete_Re
 func (m *QueuesCmds_Delete_Response) String() string { return proto1.CompactTextString(m) }
 func (*QueuesCmds_Delete_Response) ProtoMessage()    {}
 func (*QueuesCmds_Delete_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptorQueries, []int{1, 3, 1}
+	return fileDescriptorQueries, []int{1, 4, 1}
 }
 
-func init() {
-	proto1.RegisterType((*Queue)(nil), "gork_gateways_grpc.Queue")
-	proto1.RegisterType((*Queue_Setting)(nil), "gork_gateways_grpc.Queue.Setting")
-	proto1.RegisterType((*QueuesCmds)(nil), "gork_gateways_grpc.QueuesCmds")
-	proto1.RegisterType((*QueuesCmds_List)(nil), "gork_gateways_grpc.QueuesCmds.List")
-	proto1.RegisterType((*QueuesCmds_List_Request)(nil), "gork_gateways_grpc.QueuesCmds.List.Request")
-	proto1.RegisterType((*QueuesCmds_List_Response)(nil), "gork_gateways_grpc.QueuesCmds.List.Response")
-	proto1.RegisterType((*QueuesCmds_Create)(nil), "gork_gateways_grpc.QueuesCmds.Create")
-	proto1.RegisterType((*QueuesCmds_Create_Request)(nil), "gork_gateways_grpc.QueuesCmds.Create.Request")
-	proto1.RegisterType((*QueuesCmds_Create_Response)(nil), "gork_gateways_grpc.QueuesCmds.Create.Response")
-	proto1.RegisterType((*QueuesCmds_Read)(nil), "gork_gateways_grpc.QueuesCmds.Read")
-	proto1.RegisterType((*QueuesCmds_Read_Request)(nil), "gork_gateways_grpc.QueuesCmds.Read.Request")
-	proto1.RegisterType((*QueuesCmds_Read_Response)(nil), "gork_gateways_grpc.QueuesCmds.Read.Response")
-	proto1.RegisterType((*QueuesCmds_Delete)(nil), "gork_gateways_grpc.QueuesCmds.Delete")
-	proto1.RegisterType((*QueuesCmds_Delete_Request)(nil), "gork_gateways_grpc.QueuesCmds.Delete.Request")
-	proto1.RegisterType((*QueuesCmds_Delete_Response)(nil), "gork_gateways_grpc.QueuesCmds.Delete.Response")
+type QueuesCmds_Pause struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueuesCmds_Pause) Reset()                    { *m = QueuesCmds_Pause{} }
+func (m *QueuesCmds_Pause) String() string            { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Pause) ProtoMessage()               {}
+func (*QueuesCmds_Pause) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{1, 5} }
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type QueuesCmds_Pause_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
 
-// Client API for Queues service
+func (m *QueuesCmds_Pause_Request) Reset()         { *m = QueuesCmds_Pause_Request{} }
+func (m *QueuesCmds_Pause_Request) String() string { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Pause_Request) ProtoMessage()    {}
+func (*QueuesCmds_Pause_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{1, 5, 0}
+}
 
-type QueuesClient interface {
-	List(ctx context.Context, in *QueuesCmds_List_Request, opts ...grpc.CallOption) (*QueuesCmds_List_Response, error)
-	Create(ctx context.Context, in *QueuesCmds_Create_Request, opts ...grpc.CallOption) (*QueuesCmds_Create_Response, error)
-	Read(ctx context.Context, in *QueuesCmds_Read_Request, opts ...grpc.CallOption) (*QueuesCmds_Read_Response, error)
-	Delete(ctx context.Context, in *QueuesCmds_Delete_Request, opts ...grpc.CallOption) (*QueuesCmds_Delete_Response, error)
+type QueuesCmds_Pause_Response struct {
+	Record *Queue `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
 }
 
-type queuesClient struct {
-	cc *grpc.ClientConn
+func (m *QueuesCmds_Pause_Response) Reset()         { *m = QueuesCmds_Pause_Response{} }
+func (m *QueuesCmds_Pause_Response) String() string { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Pause_Response) ProtoMessage()    {}
+func (*QueuesCmds_Pause_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{1, 5, 1}
 }
 
-func NewQueuesClient(cc *grpc.ClientConn) QueuesClient {
-	return &queuesClient{cc}
+type QueuesCmds_Resume struct {
 }
 
-func (c *queuesClient) List(ctx context.Context, in *QueuesCmds_List_Request, opts ...grpc.CallOption) (*QueuesCmds_List_Response, error) {
-	out := new(QueuesCmds_List_Response)
-	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/List", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueuesCmds_Resume) Reset()                    { *m = QueuesCmds_Resume{} }
+func (m *QueuesCmds_Resume) String() string            { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Resume) ProtoMessage()               {}
+func (*QueuesCmds_Resume) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{1, 6} }
+
+type QueuesCmds_Resume_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (c *queuesClient) Create(ctx context.Context, in *QueuesCmds_Create_Request, opts ...grpc.CallOption) (*QueuesCmds_Create_Response, error) {
-	out := new(QueuesCmds_Create_Response)
-	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Create", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueuesCmds_Resume_Request) Reset()         { *m = QueuesCmds_Resume_Request{} }
+func (m *QueuesCmds_Resume_Request) String() string { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Resume_Request) ProtoMessage()    {}
+func (*QueuesCmds_Resume_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{1, 6, 0}
 }
 
-func (c *queuesClient) Read(ctx context.Context, in *QueuesCmds_Read_Request, opts ...grpc.CallOption) (*QueuesCmds_Read_Response, error) {
-	out := new(QueuesCmds_Read_Response)
-	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Read", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueuesCmds_Resume_Response struct {
+	Record *Queue `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
 }
 
-func (c *queuesClient) Delete(ctx context.Context, in *QueuesCmds_Delete_Request, opts ...grpc.CallOption) (*QueuesCmds_Delete_Response, error) {
-	out := new(QueuesCmds_Delete_Response)
-	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Delete", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueuesCmds_Resume_Response) Reset()         { *m = QueuesCmds_Resume_Response{} }
+func (m *QueuesCmds_Resume_Response) String() string { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Resume_Response) ProtoMessage()    {}
+func (*QueuesCmds_Resume_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{1, 6, 1}
 }
 
-// Server API for Queues service
+// Purge deletes pending tasks of the queue, tasks leased to the jobs are kept intact.
+type QueuesCmds_Purge struct {
+}
 
-type QueuesServer interface {
-	List(context.Context, *QueuesCmds_List_Request) (*QueuesCmds_List_Response, error)
-	Create(context.Context, *QueuesCmds_Create_Request) (*QueuesCmds_Create_Response, error)
-	Read(context.Context, *QueuesCmds_Read_Request) (*QueuesCmds_Read_Response, error)
-	Delete(context.Context, *QueuesCmds_Delete_Request) (*QueuesCmds_Delete_Response, error)
+func (m *QueuesCmds_Purge) Reset()                    { *m = QueuesCmds_Purge{} }
+func (m *QueuesCmds_Purge) String() string            { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Purge) ProtoMessage()               {}
+func (*QueuesCmds_Purge) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{1, 7} }
+
+type QueuesCmds_Purge_Request struct {
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Delayed      bool   `protobuf:"varint,2,opt,name=delayed,proto3" json:"delayed,omitempty"`
+	DeadLettered bool   `protobuf:"varint,3,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered,omitempty"`
 }
 
-func RegisterQueuesServer(s *grpc.Server, srv QueuesServer) {
-	s.RegisterService(&_Queues_serviceDesc, srv)
+func (m *QueuesCmds_Purge_Request) Reset()         { *m = QueuesCmds_Purge_Request{} }
+func (m *QueuesCmds_Purge_Request) String() string { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Purge_Request) ProtoMessage()    {}
+func (*QueuesCmds_Purge_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{1, 7, 0}
 }
 
-func _Queues_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueuesCmds_List_Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueuesServer).List(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gork_gateways_grpc.Queues/List",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueuesServer).List(ctx, req.(*QueuesCmds_List_Request))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueuesCmds_Purge_Response struct {
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func _Queues_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueuesCmds_Create_Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueuesServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gork_gateways_grpc.Queues/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueuesServer).Create(ctx, req.(*QueuesCmds_Create_Request))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueuesCmds_Purge_Response) Reset()         { *m = QueuesCmds_Purge_Response{} }
+func (m *QueuesCmds_Purge_Response) String() string { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Purge_Response) ProtoMessage()    {}
+func (*QueuesCmds_Purge_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{1, 7, 1}
 }
 
-func _Queues_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueuesCmds_Read_Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueuesServer).Read(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gork_gateways_grpc.Queues/Read",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueuesServer).Read(ctx, req.(*QueuesCmds_Read_Request))
-	}
-	return interceptor(ctx, in, info, handler)
+// Stats returns live statistics of the queue, maintained incrementally.
+type QueuesCmds_Stats struct {
 }
 
-func _Queues_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueuesCmds_Delete_Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueuesServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gork_gateways_grpc.Queues/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueuesServer).Delete(ctx, req.(*QueuesCmds_Delete_Request))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueuesCmds_Stats) Reset()                    { *m = QueuesCmds_Stats{} }
+func (m *QueuesCmds_Stats) String() string            { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Stats) ProtoMessage()               {}
+func (*QueuesCmds_Stats) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{1, 8} }
+
+type QueuesCmds_Stats_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-var _Queues_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gork_gateways_grpc.Queues",
-	HandlerType: (*QueuesServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "List",
-			Handler:    _Queues_List_Handler,
-		},
-		{
-			MethodName: "Create",
-			Handler:    _Queues_Create_Handler,
-		},
-		{
-			MethodName: "Read",
-			Handler:    _Queues_Read_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _Queues_Delete_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "queries.proto",
+func (m *QueuesCmds_Stats_Request) Reset()         { *m = QueuesCmds_Stats_Request{} }
+func (m *QueuesCmds_Stats_Request) String() string { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Stats_Request) ProtoMessage()    {}
+func (*QueuesCmds_Stats_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{1, 8, 0}
 }
 
-func (m *Queue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueuesCmds_Stats_Response struct {
+	Pending          uint64  `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Delayed          uint64  `protobuf:"varint,2,opt,name=delayed,proto3" json:"delayed,omitempty"`
+	Processing       uint64  `protobuf:"varint,3,opt,name=processing,proto3" json:"processing,omitempty"`
+	Finished         uint64  `protobuf:"varint,4,opt,name=finished,proto3" json:"finished,omitempty"`
+	Failed           uint64  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Expired          uint64  `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	DeadLettered     uint64  `protobuf:"varint,7,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered,omitempty"`
+	Enqueued         uint64  `protobuf:"varint,8,opt,name=enqueued,proto3" json:"enqueued,omitempty"`
+	Dequeued         uint64  `protobuf:"varint,9,opt,name=dequeued,proto3" json:"dequeued,omitempty"`
+	EnqueueRate      float64 `protobuf:"fixed64,10,opt,name=enqueue_rate,json=enqueueRate,proto3" json:"enqueue_rate,omitempty"`
+	DequeueRate      float64 `protobuf:"fixed64,11,opt,name=dequeue_rate,json=dequeueRate,proto3" json:"dequeue_rate,omitempty"`
+	OldestPendingAge float64 `protobuf:"fixed64,12,opt,name=oldest_pending_age,json=oldestPendingAge,proto3" json:"oldest_pending_age,omitempty"`
 }
 
-func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Settings) > 0 {
-		for _, msg := range m.Settings {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.CreatedAt) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(len(m.CreatedAt)))
-		i += copy(dAtA[i:], m.CreatedAt)
-	}
-	return i, nil
+func (m *QueuesCmds_Stats_Response) Reset()         { *m = QueuesCmds_Stats_Response{} }
+func (m *QueuesCmds_Stats_Response) String() string { return proto1.CompactTextString(m) }
+func (*QueuesCmds_Stats_Response) ProtoMessage()    {}
+func (*QueuesCmds_Stats_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{1, 8, 1}
 }
 
-func (m *Queue_Setting) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Task represents a single unit of work that should be processed by worker(s).
+type Task struct {
+	Id         string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QueueId    string         `protobuf:"bytes,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	Status     Task_Status    `protobuf:"varint,3,opt,name=status,proto3,enum=gork_gateways_grpc.Task_Status" json:"status,omitempty"`
+	Priority   uint8          `protobuf:"varint,4,opt,name=priority,proto3,casttype=uint8" json:"priority,omitempty"`
+	Headers    []*Task_Header `protobuf:"bytes,5,rep,name=headers" json:"headers,omitempty"`
+	Input      []byte         `protobuf:"bytes,6,opt,name=input,proto3" json:"input,omitempty"`
+	CreatedAt  string         `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt  string         `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	FinishedAt string         `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	RunAt      string         `protobuf:"bytes,10,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	Attempts   uint32         `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError  string         `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	JobId      string         `protobuf:"bytes,13,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Output     []byte         `protobuf:"bytes,14,opt,name=output,proto3" json:"output,omitempty"`
+	WorkflowId string         `protobuf:"bytes,15,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	BatchId    string         `protobuf:"bytes,16,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	UniqueKey  string         `protobuf:"bytes,17,opt,name=unique_key,json=uniqueKey,proto3" json:"unique_key,omitempty"`
 }
 
-func (m *Queue_Setting) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	return i, nil
+func (m *Task) Reset()                    { *m = Task{} }
+func (m *Task) String() string            { return proto1.CompactTextString(m) }
+func (*Task) ProtoMessage()               {}
+func (*Task) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{2} }
+
+type Task_Header struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *QueuesCmds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Task_Header) Reset()                    { *m = Task_Header{} }
+func (m *Task_Header) String() string            { return proto1.CompactTextString(m) }
+func (*Task_Header) ProtoMessage()               {}
+func (*Task_Header) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{2, 0} }
+
+// TasksCmds is a container that wraps request/response messages of all task-related RPC commands.
+type TasksCmds struct {
 }
 
-func (m *QueuesCmds) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+func (m *TasksCmds) Reset()                    { *m = TasksCmds{} }
+func (m *TasksCmds) String() string            { return proto1.CompactTextString(m) }
+func (*TasksCmds) ProtoMessage()               {}
+func (*TasksCmds) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{3} }
+
+type TasksCmds_Publish struct {
 }
 
-func (m *QueuesCmds_List) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TasksCmds_Publish) Reset()                    { *m = TasksCmds_Publish{} }
+func (m *TasksCmds_Publish) String() string            { return proto1.CompactTextString(m) }
+func (*TasksCmds_Publish) ProtoMessage()               {}
+func (*TasksCmds_Publish) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{3, 0} }
+
+type TasksCmds_Publish_Request struct {
+	Queue          string         `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Priority       uint8          `protobuf:"varint,2,opt,name=priority,proto3,casttype=uint8" json:"priority,omitempty"`
+	Headers        []*Task_Header `protobuf:"bytes,3,rep,name=headers" json:"headers,omitempty"`
+	Input          []byte         `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	ExpiresAt      string         `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RunAt          string         `protobuf:"bytes,6,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	Delay          uint32         `protobuf:"varint,7,opt,name=delay,proto3" json:"delay,omitempty"`
+	Exchange       string         `protobuf:"bytes,8,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	RoutingKey     string         `protobuf:"bytes,9,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+	IdempotencyKey string         `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	UniqueKey      string         `protobuf:"bytes,11,opt,name=unique_key,json=uniqueKey,proto3" json:"unique_key,omitempty"`
 }
 
-func (m *QueuesCmds_List) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+func (m *TasksCmds_Publish_Request) Reset()         { *m = TasksCmds_Publish_Request{} }
+func (m *TasksCmds_Publish_Request) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_Publish_Request) ProtoMessage()    {}
+func (*TasksCmds_Publish_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 0, 0}
 }
 
-func (m *QueuesCmds_List_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type TasksCmds_Publish_Response struct {
+	Record  *Task   `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+	Records []*Task `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
 }
 
-func (m *QueuesCmds_List_Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(m.Params.Size()))
-		n1, err := m.Params.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	return i, nil
+func (m *TasksCmds_Publish_Response) Reset()         { *m = TasksCmds_Publish_Response{} }
+func (m *TasksCmds_Publish_Response) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_Publish_Response) ProtoMessage()    {}
+func (*TasksCmds_Publish_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 0, 1}
 }
 
-func (m *QueuesCmds_List_Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type TasksCmds_Read struct {
 }
 
-func (m *QueuesCmds_List_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Info != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(m.Info.Size()))
-		n2, err := m.Info.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
-	if len(m.Records) > 0 {
-		for _, msg := range m.Records {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+func (m *TasksCmds_Read) Reset()                    { *m = TasksCmds_Read{} }
+func (m *TasksCmds_Read) String() string            { return proto1.CompactTextString(m) }
+func (*TasksCmds_Read) ProtoMessage()               {}
+func (*TasksCmds_Read) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{3, 1} }
+
+type TasksCmds_Read_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueuesCmds_Create) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TasksCmds_Read_Request) Reset()         { *m = TasksCmds_Read_Request{} }
+func (m *TasksCmds_Read_Request) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_Read_Request) ProtoMessage()    {}
+func (*TasksCmds_Read_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 1, 0}
 }
 
-func (m *QueuesCmds_Create) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+type TasksCmds_Read_Response struct {
+	Record *Task `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
 }
 
-func (m *QueuesCmds_Create_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TasksCmds_Read_Response) Reset()         { *m = TasksCmds_Read_Response{} }
+func (m *TasksCmds_Read_Response) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_Read_Response) ProtoMessage()    {}
+func (*TasksCmds_Read_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 1, 1}
 }
 
-func (m *QueuesCmds_Create_Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Settings) > 0 {
-		for _, msg := range m.Settings {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+type TasksCmds_List struct {
 }
 
-func (m *QueuesCmds_Create_Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TasksCmds_List) Reset()                    { *m = TasksCmds_List{} }
+func (m *TasksCmds_List) String() string            { return proto1.CompactTextString(m) }
+func (*TasksCmds_List) ProtoMessage()               {}
+func (*TasksCmds_List) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{3, 2} }
+
+type TasksCmds_List_Request struct {
+	QueueId string             `protobuf:"bytes,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	Params  *Collection_Params `protobuf:"bytes,2,opt,name=params" json:"params,omitempty"`
 }
 
-func (m *QueuesCmds_Create_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Record != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
-		n3, err := m.Record.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
-	}
-	return i, nil
+func (m *TasksCmds_List_Request) Reset()         { *m = TasksCmds_List_Request{} }
+func (m *TasksCmds_List_Request) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_List_Request) ProtoMessage()    {}
+func (*TasksCmds_List_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 2, 0}
 }
 
-func (m *QueuesCmds_Read) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type TasksCmds_List_Response struct {
+	Info    *Collection_Info `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Records []*Task          `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
 }
 
-func (m *QueuesCmds_Read) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+func (m *TasksCmds_List_Response) Reset()         { *m = TasksCmds_List_Response{} }
+func (m *TasksCmds_List_Response) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_List_Response) ProtoMessage()    {}
+func (*TasksCmds_List_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 2, 1}
 }
 
-func (m *QueuesCmds_Read_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type TasksCmds_Cancel struct {
 }
 
-func (m *QueuesCmds_Read_Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	return i, nil
+func (m *TasksCmds_Cancel) Reset()                    { *m = TasksCmds_Cancel{} }
+func (m *TasksCmds_Cancel) String() string            { return proto1.CompactTextString(m) }
+func (*TasksCmds_Cancel) ProtoMessage()               {}
+func (*TasksCmds_Cancel) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{3, 3} }
+
+type TasksCmds_Cancel_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueuesCmds_Read_Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TasksCmds_Cancel_Request) Reset()         { *m = TasksCmds_Cancel_Request{} }
+func (m *TasksCmds_Cancel_Request) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_Cancel_Request) ProtoMessage()    {}
+func (*TasksCmds_Cancel_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 3, 0}
 }
 
-func (m *QueuesCmds_Read_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Record != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
-		n4, err := m.Record.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
-	}
-	return i, nil
+type TasksCmds_Cancel_Response struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (m *QueuesCmds_Delete) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TasksCmds_Cancel_Response) Reset()         { *m = TasksCmds_Cancel_Response{} }
+func (m *TasksCmds_Cancel_Response) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_Cancel_Response) ProtoMessage()    {}
+func (*TasksCmds_Cancel_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 3, 1}
 }
 
-func (m *QueuesCmds_Delete) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+type TasksCmds_CountExpired struct {
 }
 
-func (m *QueuesCmds_Delete_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TasksCmds_CountExpired) Reset()         { *m = TasksCmds_CountExpired{} }
+func (m *TasksCmds_CountExpired) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_CountExpired) ProtoMessage()    {}
+func (*TasksCmds_CountExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 4}
 }
 
-func (m *QueuesCmds_Delete_Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	return i, nil
+type TasksCmds_CountExpired_Request struct {
+	QueueId string `protobuf:"bytes,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
 }
 
-func (m *QueuesCmds_Delete_Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TasksCmds_CountExpired_Request) Reset()         { *m = TasksCmds_CountExpired_Request{} }
+func (m *TasksCmds_CountExpired_Request) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_CountExpired_Request) ProtoMessage()    {}
+func (*TasksCmds_CountExpired_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 4, 0}
 }
 
-func (m *QueuesCmds_Delete_Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Result {
-		dAtA[i] = 0x8
-		i++
-		if m.Result {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	return i, nil
+type TasksCmds_CountExpired_Response struct {
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func encodeFixed64Queries(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
+func (m *TasksCmds_CountExpired_Response) Reset()         { *m = TasksCmds_CountExpired_Response{} }
+func (m *TasksCmds_CountExpired_Response) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_CountExpired_Response) ProtoMessage()    {}
+func (*TasksCmds_CountExpired_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 4, 1}
 }
-func encodeFixed32Queries(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
+
+type TasksCmds_GetResult struct {
 }
-func encodeVarintQueries(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+
+func (m *TasksCmds_GetResult) Reset()                    { *m = TasksCmds_GetResult{} }
+func (m *TasksCmds_GetResult) String() string            { return proto1.CompactTextString(m) }
+func (*TasksCmds_GetResult) ProtoMessage()               {}
+func (*TasksCmds_GetResult) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{3, 5} }
+
+type TasksCmds_GetResult_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
-func (m *Queue) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQueries(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQueries(uint64(l))
-	}
-	if len(m.Settings) > 0 {
-		for _, e := range m.Settings {
-			l = e.Size()
-			n += 1 + l + sovQueries(uint64(l))
-		}
-	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 1 + l + sovQueries(uint64(l))
+
+func (m *TasksCmds_GetResult_Request) Reset()         { *m = TasksCmds_GetResult_Request{} }
+func (m *TasksCmds_GetResult_Request) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_GetResult_Request) ProtoMessage()    {}
+func (*TasksCmds_GetResult_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 5, 0}
+}
+
+type TasksCmds_GetResult_Response struct {
+	Ready      bool        `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	Status     Task_Status `protobuf:"varint,2,opt,name=status,proto3,enum=gork_gateways_grpc.Task_Status" json:"status,omitempty"`
+	Output     []byte      `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	LastError  string      `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	FinishedAt string      `protobuf:"bytes,5,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (m *TasksCmds_GetResult_Response) Reset()         { *m = TasksCmds_GetResult_Response{} }
+func (m *TasksCmds_GetResult_Response) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_GetResult_Response) ProtoMessage()    {}
+func (*TasksCmds_GetResult_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 5, 1}
+}
+
+// AwaitResult waits until processing of the task is over or the timeout passes, whichever comes first.
+type TasksCmds_AwaitResult struct {
+}
+
+func (m *TasksCmds_AwaitResult) Reset()                    { *m = TasksCmds_AwaitResult{} }
+func (m *TasksCmds_AwaitResult) String() string            { return proto1.CompactTextString(m) }
+func (*TasksCmds_AwaitResult) ProtoMessage()               {}
+func (*TasksCmds_AwaitResult) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{3, 6} }
+
+type TasksCmds_AwaitResult_Request struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timeout uint32 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *TasksCmds_AwaitResult_Request) Reset()         { *m = TasksCmds_AwaitResult_Request{} }
+func (m *TasksCmds_AwaitResult_Request) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_AwaitResult_Request) ProtoMessage()    {}
+func (*TasksCmds_AwaitResult_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 6, 0}
+}
+
+type TasksCmds_AwaitResult_Response struct {
+	Ready      bool        `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	Status     Task_Status `protobuf:"varint,2,opt,name=status,proto3,enum=gork_gateways_grpc.Task_Status" json:"status,omitempty"`
+	Output     []byte      `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	LastError  string      `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	FinishedAt string      `protobuf:"bytes,5,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (m *TasksCmds_AwaitResult_Response) Reset()         { *m = TasksCmds_AwaitResult_Response{} }
+func (m *TasksCmds_AwaitResult_Response) String() string { return proto1.CompactTextString(m) }
+func (*TasksCmds_AwaitResult_Response) ProtoMessage()    {}
+func (*TasksCmds_AwaitResult_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{3, 6, 1}
+}
+
+// Job represents a single unit of work that is delivered to the worker.
+type Job struct {
+	Id          string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId      string         `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	QueueId     string         `protobuf:"bytes,3,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	Headers     []*Task_Header `protobuf:"bytes,4,rep,name=headers" json:"headers,omitempty"`
+	Input       []byte         `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	Progress    uint8          `protobuf:"varint,6,opt,name=progress,proto3,casttype=uint8" json:"progress,omitempty"`
+	Logs        []string       `protobuf:"bytes,7,rep,name=logs" json:"logs,omitempty"`
+	CreatedAt   string         `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeliveredAt string         `protobuf:"bytes,9,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	FinishedAt  string         `protobuf:"bytes,10,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	WorkerId    string         `protobuf:"bytes,11,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+}
+
+func (m *Job) Reset()                    { *m = Job{} }
+func (m *Job) String() string            { return proto1.CompactTextString(m) }
+func (*Job) ProtoMessage()               {}
+func (*Job) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{4} }
+
+// JobsCmds is a container that wraps request/response messages of all job-related RPC commands.
+type JobsCmds struct {
+}
+
+func (m *JobsCmds) Reset()                    { *m = JobsCmds{} }
+func (m *JobsCmds) String() string            { return proto1.CompactTextString(m) }
+func (*JobsCmds) ProtoMessage()               {}
+func (*JobsCmds) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{5} }
+
+type JobsCmds_Consume struct {
+}
+
+func (m *JobsCmds_Consume) Reset()                    { *m = JobsCmds_Consume{} }
+func (m *JobsCmds_Consume) String() string            { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume) ProtoMessage()               {}
+func (*JobsCmds_Consume) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{5, 0} }
+
+type JobsCmds_Consume_Request struct {
+	// Types that are valid to be assigned to Command:
+	//	*JobsCmds_Consume_Request_Subscribe
+	//	*JobsCmds_Consume_Request_Ack
+	//	*JobsCmds_Consume_Request_Nack
+	//	*JobsCmds_Consume_Request_Reject
+	//	*JobsCmds_Consume_Request_Progress
+	//	*JobsCmds_Consume_Request_Log
+	//	*JobsCmds_Consume_Request_Touch
+	Command isJobsCmds_Consume_Request_Command `protobuf_oneof:"command"`
+}
+
+func (m *JobsCmds_Consume_Request) Reset()         { *m = JobsCmds_Consume_Request{} }
+func (m *JobsCmds_Consume_Request) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume_Request) ProtoMessage()    {}
+func (*JobsCmds_Consume_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 0, 0}
+}
+
+type isJobsCmds_Consume_Request_Command interface {
+	isJobsCmds_Consume_Request_Command()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type JobsCmds_Consume_Request_Subscribe struct {
+	Subscribe *JobsCmds_Consume_Subscribe `protobuf:"bytes,1,opt,name=subscribe,oneof"`
+}
+type JobsCmds_Consume_Request_Ack struct {
+	Ack *JobsCmds_Consume_Ack `protobuf:"bytes,2,opt,name=ack,oneof"`
+}
+type JobsCmds_Consume_Request_Nack struct {
+	Nack *JobsCmds_Consume_Nack `protobuf:"bytes,3,opt,name=nack,oneof"`
+}
+type JobsCmds_Consume_Request_Reject struct {
+	Reject *JobsCmds_Consume_Reject `protobuf:"bytes,4,opt,name=reject,oneof"`
+}
+type JobsCmds_Consume_Request_Progress struct {
+	Progress *JobsCmds_Consume_Progress `protobuf:"bytes,5,opt,name=progress,oneof"`
+}
+type JobsCmds_Consume_Request_Log struct {
+	Log *JobsCmds_Consume_Log `protobuf:"bytes,6,opt,name=log,oneof"`
+}
+type JobsCmds_Consume_Request_Touch struct {
+	Touch *JobsCmds_Consume_Touch `protobuf:"bytes,7,opt,name=touch,oneof"`
+}
+
+func (*JobsCmds_Consume_Request_Subscribe) isJobsCmds_Consume_Request_Command() {}
+func (*JobsCmds_Consume_Request_Ack) isJobsCmds_Consume_Request_Command()       {}
+func (*JobsCmds_Consume_Request_Nack) isJobsCmds_Consume_Request_Command()      {}
+func (*JobsCmds_Consume_Request_Reject) isJobsCmds_Consume_Request_Command()    {}
+func (*JobsCmds_Consume_Request_Progress) isJobsCmds_Consume_Request_Command()  {}
+func (*JobsCmds_Consume_Request_Log) isJobsCmds_Consume_Request_Command()       {}
+func (*JobsCmds_Consume_Request_Touch) isJobsCmds_Consume_Request_Command()     {}
+
+func (m *JobsCmds_Consume_Request) GetCommand() isJobsCmds_Consume_Request_Command {
+	if m != nil {
+		return m.Command
 	}
-	return n
+	return nil
 }
 
-func (m *Queue_Setting) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQueries(uint64(l))
+func (m *JobsCmds_Consume_Request) GetSubscribe() *JobsCmds_Consume_Subscribe {
+	if x, ok := m.GetCommand().(*JobsCmds_Consume_Request_Subscribe); ok {
+		return x.Subscribe
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQueries(uint64(l))
+	return nil
+}
+
+func (m *JobsCmds_Consume_Request) GetAck() *JobsCmds_Consume_Ack {
+	if x, ok := m.GetCommand().(*JobsCmds_Consume_Request_Ack); ok {
+		return x.Ack
 	}
-	return n
+	return nil
 }
 
-func (m *QueuesCmds) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *JobsCmds_Consume_Request) GetNack() *JobsCmds_Consume_Nack {
+	if x, ok := m.GetCommand().(*JobsCmds_Consume_Request_Nack); ok {
+		return x.Nack
+	}
+	return nil
 }
 
-func (m *QueuesCmds_List) Size() (n int) {
-	var l int
-	_ = l
-	return n
+func (m *JobsCmds_Consume_Request) GetReject() *JobsCmds_Consume_Reject {
+	if x, ok := m.GetCommand().(*JobsCmds_Consume_Request_Reject); ok {
+		return x.Reject
+	}
+	return nil
 }
 
-func (m *QueuesCmds_List_Request) Size() (n int) {
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQueries(uint64(l))
+func (m *JobsCmds_Consume_Request) GetProgress() *JobsCmds_Consume_Progress {
+	if x, ok := m.GetCommand().(*JobsCmds_Consume_Request_Progress); ok {
+		return x.Progress
 	}
-	return n
+	return nil
 }
 
-func (m *QueuesCmds_List_Response) Size() (n int) {
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQueries(uint64(l))
+func (m *JobsCmds_Consume_Request) GetLog() *JobsCmds_Consume_Log {
+	if x, ok := m.GetCommand().(*JobsCmds_Consume_Request_Log); ok {
+		return x.Log
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQueries(uint64(l))
-		}
+	return nil
+}
+
+func (m *JobsCmds_Consume_Request) GetTouch() *JobsCmds_Consume_Touch {
+	if x, ok := m.GetCommand().(*JobsCmds_Consume_Request_Touch); ok {
+		return x.Touch
 	}
-	return n
+	return nil
 }
 
-func (m *QueuesCmds_Create) Size() (n int) {
-	var l int
-	_ = l
-	return n
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*JobsCmds_Consume_Request) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
+	return _JobsCmds_Consume_Request_OneofMarshaler, _JobsCmds_Consume_Request_OneofUnmarshaler, _JobsCmds_Consume_Request_OneofSizer, []interface{}{
+		(*JobsCmds_Consume_Request_Subscribe)(nil),
+		(*JobsCmds_Consume_Request_Ack)(nil),
+		(*JobsCmds_Consume_Request_Nack)(nil),
+		(*JobsCmds_Consume_Request_Reject)(nil),
+		(*JobsCmds_Consume_Request_Progress)(nil),
+		(*JobsCmds_Consume_Request_Log)(nil),
+		(*JobsCmds_Consume_Request_Touch)(nil),
+	}
 }
 
-func (m *QueuesCmds_Create_Request) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQueries(uint64(l))
+func _JobsCmds_Consume_Request_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
+	m := msg.(*JobsCmds_Consume_Request)
+	// command
+	switch x := m.Command.(type) {
+	case *JobsCmds_Consume_Request_Subscribe:
+		_ = b.EncodeVarint(1<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.Subscribe); err != nil {
+			return err
+		}
+	case *JobsCmds_Consume_Request_Ack:
+		_ = b.EncodeVarint(2<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.Ack); err != nil {
+			return err
+		}
+	case *JobsCmds_Consume_Request_Nack:
+		_ = b.EncodeVarint(3<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.Nack); err != nil {
+			return err
+		}
+	case *JobsCmds_Consume_Request_Reject:
+		_ = b.EncodeVarint(4<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.Reject); err != nil {
+			return err
+		}
+	case *JobsCmds_Consume_Request_Progress:
+		_ = b.EncodeVarint(5<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.Progress); err != nil {
+			return err
+		}
+	case *JobsCmds_Consume_Request_Log:
+		_ = b.EncodeVarint(6<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.Log); err != nil {
+			return err
+		}
+	case *JobsCmds_Consume_Request_Touch:
+		_ = b.EncodeVarint(7<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.Touch); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("JobsCmds_Consume_Request.Command has unexpected type %T", x)
 	}
-	if len(m.Settings) > 0 {
-		for _, e := range m.Settings {
-			l = e.Size()
-			n += 1 + l + sovQueries(uint64(l))
+	return nil
+}
+
+func _JobsCmds_Consume_Request_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error) {
+	m := msg.(*JobsCmds_Consume_Request)
+	switch tag {
+	case 1: // command.subscribe
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(JobsCmds_Consume_Subscribe)
+		err := b.DecodeMessage(msg)
+		m.Command = &JobsCmds_Consume_Request_Subscribe{msg}
+		return true, err
+	case 2: // command.ack
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(JobsCmds_Consume_Ack)
+		err := b.DecodeMessage(msg)
+		m.Command = &JobsCmds_Consume_Request_Ack{msg}
+		return true, err
+	case 3: // command.nack
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(JobsCmds_Consume_Nack)
+		err := b.DecodeMessage(msg)
+		m.Command = &JobsCmds_Consume_Request_Nack{msg}
+		return true, err
+	case 4: // command.reject
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(JobsCmds_Consume_Reject)
+		err := b.DecodeMessage(msg)
+		m.Command = &JobsCmds_Consume_Request_Reject{msg}
+		return true, err
+	case 5: // command.progress
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
 		}
+		msg := new(JobsCmds_Consume_Progress)
+		err := b.DecodeMessage(msg)
+		m.Command = &JobsCmds_Consume_Request_Progress{msg}
+		return true, err
+	case 6: // command.log
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(JobsCmds_Consume_Log)
+		err := b.DecodeMessage(msg)
+		m.Command = &JobsCmds_Consume_Request_Log{msg}
+		return true, err
+	case 7: // command.touch
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(JobsCmds_Consume_Touch)
+		err := b.DecodeMessage(msg)
+		m.Command = &JobsCmds_Consume_Request_Touch{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	return n
 }
 
-func (m *QueuesCmds_Create_Response) Size() (n int) {
-	var l int
-	_ = l
-	if m.Record != nil {
-		l = m.Record.Size()
-		n += 1 + l + sovQueries(uint64(l))
+func _JobsCmds_Consume_Request_OneofSizer(msg proto1.Message) (n int) {
+	m := msg.(*JobsCmds_Consume_Request)
+	// command
+	switch x := m.Command.(type) {
+	case *JobsCmds_Consume_Request_Subscribe:
+		s := proto1.Size(x.Subscribe)
+		n += proto1.SizeVarint(1<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *JobsCmds_Consume_Request_Ack:
+		s := proto1.Size(x.Ack)
+		n += proto1.SizeVarint(2<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *JobsCmds_Consume_Request_Nack:
+		s := proto1.Size(x.Nack)
+		n += proto1.SizeVarint(3<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *JobsCmds_Consume_Request_Reject:
+		s := proto1.Size(x.Reject)
+		n += proto1.SizeVarint(4<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *JobsCmds_Consume_Request_Progress:
+		s := proto1.Size(x.Progress)
+		n += proto1.SizeVarint(5<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *JobsCmds_Consume_Request_Log:
+		s := proto1.Size(x.Log)
+		n += proto1.SizeVarint(6<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *JobsCmds_Consume_Request_Touch:
+		s := proto1.Size(x.Touch)
+		n += proto1.SizeVarint(7<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
 	return n
 }
 
-func (m *QueuesCmds_Read) Size() (n int) {
-	var l int
-	_ = l
-	return n
+type JobsCmds_Consume_Response struct {
+	Job     *Job                      `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	Failure *JobsCmds_Consume_Failure `protobuf:"bytes,2,opt,name=failure" json:"failure,omitempty"`
 }
 
-func (m *QueuesCmds_Read_Request) Size() (n int) {
-	var l int
-	_ = l
+func (m *JobsCmds_Consume_Response) Reset()         { *m = JobsCmds_Consume_Response{} }
+func (m *JobsCmds_Consume_Response) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume_Response) ProtoMessage()    {}
+func (*JobsCmds_Consume_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 0, 1}
+}
+
+// Failure reports a worker command that could not be applied, e.g. an ack of the job whose lease expired.
+type JobsCmds_Consume_Failure struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *JobsCmds_Consume_Failure) Reset()         { *m = JobsCmds_Consume_Failure{} }
+func (m *JobsCmds_Consume_Failure) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume_Failure) ProtoMessage()    {}
+func (*JobsCmds_Consume_Failure) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 0, 2}
+}
+
+// Subscribe starts delivery of the jobs from the given queues.
+type JobsCmds_Consume_Subscribe struct {
+	Queues   []string `protobuf:"bytes,1,rep,name=queues" json:"queues,omitempty"`
+	Prefetch uint32   `protobuf:"varint,2,opt,name=prefetch,proto3" json:"prefetch,omitempty"`
+	WorkerId string   `protobuf:"bytes,3,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+}
+
+func (m *JobsCmds_Consume_Subscribe) Reset()         { *m = JobsCmds_Consume_Subscribe{} }
+func (m *JobsCmds_Consume_Subscribe) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume_Subscribe) ProtoMessage()    {}
+func (*JobsCmds_Consume_Subscribe) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 0, 3}
+}
+
+// Ack reports that the job was processed successfully.
+type JobsCmds_Consume_Ack struct {
+	JobId  string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Output []byte `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (m *JobsCmds_Consume_Ack) Reset()         { *m = JobsCmds_Consume_Ack{} }
+func (m *JobsCmds_Consume_Ack) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume_Ack) ProtoMessage()    {}
+func (*JobsCmds_Consume_Ack) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 0, 4}
+}
+
+// Nack reports that the job was not processed and the task should be retried according to the queue retry policy.
+type JobsCmds_Consume_Nack struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *JobsCmds_Consume_Nack) Reset()         { *m = JobsCmds_Consume_Nack{} }
+func (m *JobsCmds_Consume_Nack) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume_Nack) ProtoMessage()    {}
+func (*JobsCmds_Consume_Nack) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 0, 5}
+}
+
+// Reject reports that the job can not be processed and the task should not be delivered again.
+type JobsCmds_Consume_Reject struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *JobsCmds_Consume_Reject) Reset()         { *m = JobsCmds_Consume_Reject{} }
+func (m *JobsCmds_Consume_Reject) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume_Reject) ProtoMessage()    {}
+func (*JobsCmds_Consume_Reject) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 0, 6}
+}
+
+// Progress reports processing progress of the job.
+type JobsCmds_Consume_Progress struct {
+	JobId    string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Progress uint8  `protobuf:"varint,2,opt,name=progress,proto3,casttype=uint8" json:"progress,omitempty"`
+}
+
+func (m *JobsCmds_Consume_Progress) Reset()         { *m = JobsCmds_Consume_Progress{} }
+func (m *JobsCmds_Consume_Progress) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume_Progress) ProtoMessage()    {}
+func (*JobsCmds_Consume_Progress) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 0, 7}
+}
+
+// Log appends lines to the log of the job.
+type JobsCmds_Consume_Log struct {
+	JobId string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Lines []string `protobuf:"bytes,2,rep,name=lines" json:"lines,omitempty"`
+}
+
+func (m *JobsCmds_Consume_Log) Reset()         { *m = JobsCmds_Consume_Log{} }
+func (m *JobsCmds_Consume_Log) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume_Log) ProtoMessage()    {}
+func (*JobsCmds_Consume_Log) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 0, 8}
+}
+
+// Touch extends the lease of the job, so its task is not redelivered while it is still being processed.
+// Progress extends the lease as well.
+type JobsCmds_Consume_Touch struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (m *JobsCmds_Consume_Touch) Reset()         { *m = JobsCmds_Consume_Touch{} }
+func (m *JobsCmds_Consume_Touch) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Consume_Touch) ProtoMessage()    {}
+func (*JobsCmds_Consume_Touch) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 0, 9}
+}
+
+type JobsCmds_Read struct {
+}
+
+func (m *JobsCmds_Read) Reset()                    { *m = JobsCmds_Read{} }
+func (m *JobsCmds_Read) String() string            { return proto1.CompactTextString(m) }
+func (*JobsCmds_Read) ProtoMessage()               {}
+func (*JobsCmds_Read) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{5, 1} }
+
+type JobsCmds_Read_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *JobsCmds_Read_Request) Reset()         { *m = JobsCmds_Read_Request{} }
+func (m *JobsCmds_Read_Request) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Read_Request) ProtoMessage()    {}
+func (*JobsCmds_Read_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 1, 0}
+}
+
+type JobsCmds_Read_Response struct {
+	Record *Job `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *JobsCmds_Read_Response) Reset()         { *m = JobsCmds_Read_Response{} }
+func (m *JobsCmds_Read_Response) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Read_Response) ProtoMessage()    {}
+func (*JobsCmds_Read_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 1, 1}
+}
+
+type JobsCmds_Tail struct {
+}
+
+func (m *JobsCmds_Tail) Reset()                    { *m = JobsCmds_Tail{} }
+func (m *JobsCmds_Tail) String() string            { return proto1.CompactTextString(m) }
+func (*JobsCmds_Tail) ProtoMessage()               {}
+func (*JobsCmds_Tail) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{5, 2} }
+
+type JobsCmds_Tail_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *JobsCmds_Tail_Request) Reset()         { *m = JobsCmds_Tail_Request{} }
+func (m *JobsCmds_Tail_Request) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Tail_Request) ProtoMessage()    {}
+func (*JobsCmds_Tail_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 2, 0}
+}
+
+// Response is sent every time progress of the job changes or new log lines are appended.
+// Stream ends once the job is finished.
+type JobsCmds_Tail_Response struct {
+	Progress uint8    `protobuf:"varint,1,opt,name=progress,proto3,casttype=uint8" json:"progress,omitempty"`
+	Lines    []string `protobuf:"bytes,2,rep,name=lines" json:"lines,omitempty"`
+	Finished bool     `protobuf:"varint,3,opt,name=finished,proto3" json:"finished,omitempty"`
+}
+
+func (m *JobsCmds_Tail_Response) Reset()         { *m = JobsCmds_Tail_Response{} }
+func (m *JobsCmds_Tail_Response) String() string { return proto1.CompactTextString(m) }
+func (*JobsCmds_Tail_Response) ProtoMessage()    {}
+func (*JobsCmds_Tail_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{5, 2, 1}
+}
+
+// Schedule represents a recurring task template that is put into the queue at every firing time of the cron expression.
+type Schedule struct {
+	Id         string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QueueId    string         `protobuf:"bytes,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	Expression string         `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	Priority   uint8          `protobuf:"varint,4,opt,name=priority,proto3,casttype=uint8" json:"priority,omitempty"`
+	Headers    []*Task_Header `protobuf:"bytes,5,rep,name=headers" json:"headers,omitempty"`
+	Input      []byte         `protobuf:"bytes,6,opt,name=input,proto3" json:"input,omitempty"`
+	NextRunAt  string         `protobuf:"bytes,7,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	CreatedAt  string         `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *Schedule) Reset()                    { *m = Schedule{} }
+func (m *Schedule) String() string            { return proto1.CompactTextString(m) }
+func (*Schedule) ProtoMessage()               {}
+func (*Schedule) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{6} }
+
+// SchedulesCmds is a container that wraps request/response messages of all schedule-related RPC commands.
+type SchedulesCmds struct {
+}
+
+func (m *SchedulesCmds) Reset()                    { *m = SchedulesCmds{} }
+func (m *SchedulesCmds) String() string            { return proto1.CompactTextString(m) }
+func (*SchedulesCmds) ProtoMessage()               {}
+func (*SchedulesCmds) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{7} }
+
+type SchedulesCmds_List struct {
+}
+
+func (m *SchedulesCmds_List) Reset()                    { *m = SchedulesCmds_List{} }
+func (m *SchedulesCmds_List) String() string            { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_List) ProtoMessage()               {}
+func (*SchedulesCmds_List) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{7, 0} }
+
+type SchedulesCmds_List_Request struct {
+	Params *Collection_Params `protobuf:"bytes,1,opt,name=params" json:"params,omitempty"`
+}
+
+func (m *SchedulesCmds_List_Request) Reset()         { *m = SchedulesCmds_List_Request{} }
+func (m *SchedulesCmds_List_Request) String() string { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_List_Request) ProtoMessage()    {}
+func (*SchedulesCmds_List_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{7, 0, 0}
+}
+
+type SchedulesCmds_List_Response struct {
+	Info    *Collection_Info `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Records []*Schedule      `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *SchedulesCmds_List_Response) Reset()         { *m = SchedulesCmds_List_Response{} }
+func (m *SchedulesCmds_List_Response) String() string { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_List_Response) ProtoMessage()    {}
+func (*SchedulesCmds_List_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{7, 0, 1}
+}
+
+type SchedulesCmds_Create struct {
+}
+
+func (m *SchedulesCmds_Create) Reset()                    { *m = SchedulesCmds_Create{} }
+func (m *SchedulesCmds_Create) String() string            { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Create) ProtoMessage()               {}
+func (*SchedulesCmds_Create) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{7, 1} }
+
+type SchedulesCmds_Create_Request struct {
+	Queue      string         `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Expression string         `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	Priority   uint8          `protobuf:"varint,3,opt,name=priority,proto3,casttype=uint8" json:"priority,omitempty"`
+	Headers    []*Task_Header `protobuf:"bytes,4,rep,name=headers" json:"headers,omitempty"`
+	Input      []byte         `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (m *SchedulesCmds_Create_Request) Reset()         { *m = SchedulesCmds_Create_Request{} }
+func (m *SchedulesCmds_Create_Request) String() string { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Create_Request) ProtoMessage()    {}
+func (*SchedulesCmds_Create_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{7, 1, 0}
+}
+
+type SchedulesCmds_Create_Response struct {
+	Record *Schedule `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *SchedulesCmds_Create_Response) Reset()         { *m = SchedulesCmds_Create_Response{} }
+func (m *SchedulesCmds_Create_Response) String() string { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Create_Response) ProtoMessage()    {}
+func (*SchedulesCmds_Create_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{7, 1, 1}
+}
+
+type SchedulesCmds_Read struct {
+}
+
+func (m *SchedulesCmds_Read) Reset()                    { *m = SchedulesCmds_Read{} }
+func (m *SchedulesCmds_Read) String() string            { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Read) ProtoMessage()               {}
+func (*SchedulesCmds_Read) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{7, 2} }
+
+type SchedulesCmds_Read_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *SchedulesCmds_Read_Request) Reset()         { *m = SchedulesCmds_Read_Request{} }
+func (m *SchedulesCmds_Read_Request) String() string { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Read_Request) ProtoMessage()    {}
+func (*SchedulesCmds_Read_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{7, 2, 0}
+}
+
+type SchedulesCmds_Read_Response struct {
+	Record *Schedule `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *SchedulesCmds_Read_Response) Reset()         { *m = SchedulesCmds_Read_Response{} }
+func (m *SchedulesCmds_Read_Response) String() string { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Read_Response) ProtoMessage()    {}
+func (*SchedulesCmds_Read_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{7, 2, 1}
+}
+
+type SchedulesCmds_Update struct {
+}
+
+func (m *SchedulesCmds_Update) Reset()                    { *m = SchedulesCmds_Update{} }
+func (m *SchedulesCmds_Update) String() string            { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Update) ProtoMessage()               {}
+func (*SchedulesCmds_Update) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{7, 3} }
+
+type SchedulesCmds_Update_Request struct {
+	Id         string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Expression string         `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	Priority   uint8          `protobuf:"varint,3,opt,name=priority,proto3,casttype=uint8" json:"priority,omitempty"`
+	Headers    []*Task_Header `protobuf:"bytes,4,rep,name=headers" json:"headers,omitempty"`
+	Input      []byte         `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (m *SchedulesCmds_Update_Request) Reset()         { *m = SchedulesCmds_Update_Request{} }
+func (m *SchedulesCmds_Update_Request) String() string { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Update_Request) ProtoMessage()    {}
+func (*SchedulesCmds_Update_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{7, 3, 0}
+}
+
+type SchedulesCmds_Update_Response struct {
+	Record *Schedule `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *SchedulesCmds_Update_Response) Reset()         { *m = SchedulesCmds_Update_Response{} }
+func (m *SchedulesCmds_Update_Response) String() string { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Update_Response) ProtoMessage()    {}
+func (*SchedulesCmds_Update_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{7, 3, 1}
+}
+
+type SchedulesCmds_Delete struct {
+}
+
+func (m *SchedulesCmds_Delete) Reset()                    { *m = SchedulesCmds_Delete{} }
+func (m *SchedulesCmds_Delete) String() string            { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Delete) ProtoMessage()               {}
+func (*SchedulesCmds_Delete) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{7, 4} }
+
+type SchedulesCmds_Delete_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *SchedulesCmds_Delete_Request) Reset()         { *m = SchedulesCmds_Delete_Request{} }
+func (m *SchedulesCmds_Delete_Request) String() string { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Delete_Request) ProtoMessage()    {}
+func (*SchedulesCmds_Delete_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{7, 4, 0}
+}
+
+type SchedulesCmds_Delete_Response struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SchedulesCmds_Delete_Response) Reset()         { *m = SchedulesCmds_Delete_Response{} }
+func (m *SchedulesCmds_Delete_Response) String() string { return proto1.CompactTextString(m) }
+func (*SchedulesCmds_Delete_Response) ProtoMessage()    {}
+func (*SchedulesCmds_Delete_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{7, 4, 1}
+}
+
+type Worker struct {
+	Id          string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Hostname    string        `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Version     string        `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Queues      []string      `protobuf:"bytes,5,rep,name=queues" json:"queues,omitempty"`
+	Concurrency uint32        `protobuf:"varint,6,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Status      Worker_Status `protobuf:"varint,7,opt,name=status,proto3,enum=gork_gateways_grpc.Worker_Status" json:"status,omitempty"`
+	JobIds      []string      `protobuf:"bytes,8,rep,name=job_ids,json=jobIds" json:"job_ids,omitempty"`
+	CreatedAt   string        `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	HeartbeatAt string        `protobuf:"bytes,10,opt,name=heartbeat_at,json=heartbeatAt,proto3" json:"heartbeat_at,omitempty"`
+}
+
+func (m *Worker) Reset()                    { *m = Worker{} }
+func (m *Worker) String() string            { return proto1.CompactTextString(m) }
+func (*Worker) ProtoMessage()               {}
+func (*Worker) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{8} }
+
+// WorkersCmds is a container that wraps request/response messages of all worker-related RPC commands.
+type WorkersCmds struct {
+}
+
+func (m *WorkersCmds) Reset()                    { *m = WorkersCmds{} }
+func (m *WorkersCmds) String() string            { return proto1.CompactTextString(m) }
+func (*WorkersCmds) ProtoMessage()               {}
+func (*WorkersCmds) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{9} }
+
+type WorkersCmds_List struct {
+}
+
+func (m *WorkersCmds_List) Reset()                    { *m = WorkersCmds_List{} }
+func (m *WorkersCmds_List) String() string            { return proto1.CompactTextString(m) }
+func (*WorkersCmds_List) ProtoMessage()               {}
+func (*WorkersCmds_List) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{9, 0} }
+
+type WorkersCmds_List_Request struct {
+	Params *Collection_Params `protobuf:"bytes,1,opt,name=params" json:"params,omitempty"`
+}
+
+func (m *WorkersCmds_List_Request) Reset()         { *m = WorkersCmds_List_Request{} }
+func (m *WorkersCmds_List_Request) String() string { return proto1.CompactTextString(m) }
+func (*WorkersCmds_List_Request) ProtoMessage()    {}
+func (*WorkersCmds_List_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{9, 0, 0}
+}
+
+type WorkersCmds_List_Response struct {
+	Info    *Collection_Info `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Records []*Worker        `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *WorkersCmds_List_Response) Reset()         { *m = WorkersCmds_List_Response{} }
+func (m *WorkersCmds_List_Response) String() string { return proto1.CompactTextString(m) }
+func (*WorkersCmds_List_Response) ProtoMessage()    {}
+func (*WorkersCmds_List_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{9, 0, 1}
+}
+
+// Create registers a new live worker.
+type WorkersCmds_Create struct {
+}
+
+func (m *WorkersCmds_Create) Reset()                    { *m = WorkersCmds_Create{} }
+func (m *WorkersCmds_Create) String() string            { return proto1.CompactTextString(m) }
+func (*WorkersCmds_Create) ProtoMessage()               {}
+func (*WorkersCmds_Create) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{9, 1} }
+
+type WorkersCmds_Create_Request struct {
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hostname    string   `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Version     string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Queues      []string `protobuf:"bytes,4,rep,name=queues" json:"queues,omitempty"`
+	Concurrency uint32   `protobuf:"varint,5,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+}
+
+func (m *WorkersCmds_Create_Request) Reset()         { *m = WorkersCmds_Create_Request{} }
+func (m *WorkersCmds_Create_Request) String() string { return proto1.CompactTextString(m) }
+func (*WorkersCmds_Create_Request) ProtoMessage()    {}
+func (*WorkersCmds_Create_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{9, 1, 0}
+}
+
+type WorkersCmds_Create_Response struct {
+	Record *Worker `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *WorkersCmds_Create_Response) Reset()         { *m = WorkersCmds_Create_Response{} }
+func (m *WorkersCmds_Create_Response) String() string { return proto1.CompactTextString(m) }
+func (*WorkersCmds_Create_Response) ProtoMessage()    {}
+func (*WorkersCmds_Create_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{9, 1, 1}
+}
+
+type WorkersCmds_Read struct {
+}
+
+func (m *WorkersCmds_Read) Reset()                    { *m = WorkersCmds_Read{} }
+func (m *WorkersCmds_Read) String() string            { return proto1.CompactTextString(m) }
+func (*WorkersCmds_Read) ProtoMessage()               {}
+func (*WorkersCmds_Read) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{9, 2} }
+
+type WorkersCmds_Read_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *WorkersCmds_Read_Request) Reset()         { *m = WorkersCmds_Read_Request{} }
+func (m *WorkersCmds_Read_Request) String() string { return proto1.CompactTextString(m) }
+func (*WorkersCmds_Read_Request) ProtoMessage()    {}
+func (*WorkersCmds_Read_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{9, 2, 0}
+}
+
+type WorkersCmds_Read_Response struct {
+	Record *Worker `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *WorkersCmds_Read_Response) Reset()         { *m = WorkersCmds_Read_Response{} }
+func (m *WorkersCmds_Read_Response) String() string { return proto1.CompactTextString(m) }
+func (*WorkersCmds_Read_Response) ProtoMessage()    {}
+func (*WorkersCmds_Read_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{9, 2, 1}
+}
+
+// Heartbeat must be sent periodically, otherwise the worker is marked as dead and its jobs are released.
+type WorkersCmds_Heartbeat struct {
+}
+
+func (m *WorkersCmds_Heartbeat) Reset()                    { *m = WorkersCmds_Heartbeat{} }
+func (m *WorkersCmds_Heartbeat) String() string            { return proto1.CompactTextString(m) }
+func (*WorkersCmds_Heartbeat) ProtoMessage()               {}
+func (*WorkersCmds_Heartbeat) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{9, 3} }
+
+type WorkersCmds_Heartbeat_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *WorkersCmds_Heartbeat_Request) Reset()         { *m = WorkersCmds_Heartbeat_Request{} }
+func (m *WorkersCmds_Heartbeat_Request) String() string { return proto1.CompactTextString(m) }
+func (*WorkersCmds_Heartbeat_Request) ProtoMessage()    {}
+func (*WorkersCmds_Heartbeat_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{9, 3, 0}
+}
+
+type WorkersCmds_Heartbeat_Response struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *WorkersCmds_Heartbeat_Response) Reset()         { *m = WorkersCmds_Heartbeat_Response{} }
+func (m *WorkersCmds_Heartbeat_Response) String() string { return proto1.CompactTextString(m) }
+func (*WorkersCmds_Heartbeat_Response) ProtoMessage()    {}
+func (*WorkersCmds_Heartbeat_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{9, 3, 1}
+}
+
+type Exchange struct {
+	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt string        `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Type      Exchange_Type `protobuf:"varint,4,opt,name=type,proto3,enum=gork_gateways_grpc.Exchange_Type" json:"type,omitempty"`
+}
+
+func (m *Exchange) Reset()                    { *m = Exchange{} }
+func (m *Exchange) String() string            { return proto1.CompactTextString(m) }
+func (*Exchange) ProtoMessage()               {}
+func (*Exchange) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{10} }
+
+// ExchangesCmds is a container that wraps request/response messages of all exchange-related RPC commands.
+type ExchangesCmds struct {
+}
+
+func (m *ExchangesCmds) Reset()                    { *m = ExchangesCmds{} }
+func (m *ExchangesCmds) String() string            { return proto1.CompactTextString(m) }
+func (*ExchangesCmds) ProtoMessage()               {}
+func (*ExchangesCmds) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{11} }
+
+type ExchangesCmds_List struct {
+}
+
+func (m *ExchangesCmds_List) Reset()                    { *m = ExchangesCmds_List{} }
+func (m *ExchangesCmds_List) String() string            { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_List) ProtoMessage()               {}
+func (*ExchangesCmds_List) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{11, 0} }
+
+type ExchangesCmds_List_Request struct {
+	Params *Collection_Params `protobuf:"bytes,1,opt,name=params" json:"params,omitempty"`
+}
+
+func (m *ExchangesCmds_List_Request) Reset()         { *m = ExchangesCmds_List_Request{} }
+func (m *ExchangesCmds_List_Request) String() string { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_List_Request) ProtoMessage()    {}
+func (*ExchangesCmds_List_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{11, 0, 0}
+}
+
+type ExchangesCmds_List_Response struct {
+	Info    *Collection_Info `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Records []*Exchange      `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *ExchangesCmds_List_Response) Reset()         { *m = ExchangesCmds_List_Response{} }
+func (m *ExchangesCmds_List_Response) String() string { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_List_Response) ProtoMessage()    {}
+func (*ExchangesCmds_List_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{11, 0, 1}
+}
+
+type ExchangesCmds_Create struct {
+}
+
+func (m *ExchangesCmds_Create) Reset()                    { *m = ExchangesCmds_Create{} }
+func (m *ExchangesCmds_Create) String() string            { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_Create) ProtoMessage()               {}
+func (*ExchangesCmds_Create) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{11, 1} }
+
+type ExchangesCmds_Create_Request struct {
+	Name string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type Exchange_Type `protobuf:"varint,2,opt,name=type,proto3,enum=gork_gateways_grpc.Exchange_Type" json:"type,omitempty"`
+}
+
+func (m *ExchangesCmds_Create_Request) Reset()         { *m = ExchangesCmds_Create_Request{} }
+func (m *ExchangesCmds_Create_Request) String() string { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_Create_Request) ProtoMessage()    {}
+func (*ExchangesCmds_Create_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{11, 1, 0}
+}
+
+type ExchangesCmds_Create_Response struct {
+	Record *Exchange `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *ExchangesCmds_Create_Response) Reset()         { *m = ExchangesCmds_Create_Response{} }
+func (m *ExchangesCmds_Create_Response) String() string { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_Create_Response) ProtoMessage()    {}
+func (*ExchangesCmds_Create_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{11, 1, 1}
+}
+
+type ExchangesCmds_Read struct {
+}
+
+func (m *ExchangesCmds_Read) Reset()                    { *m = ExchangesCmds_Read{} }
+func (m *ExchangesCmds_Read) String() string            { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_Read) ProtoMessage()               {}
+func (*ExchangesCmds_Read) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{11, 2} }
+
+type ExchangesCmds_Read_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *ExchangesCmds_Read_Request) Reset()         { *m = ExchangesCmds_Read_Request{} }
+func (m *ExchangesCmds_Read_Request) String() string { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_Read_Request) ProtoMessage()    {}
+func (*ExchangesCmds_Read_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{11, 2, 0}
+}
+
+type ExchangesCmds_Read_Response struct {
+	Record *Exchange `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *ExchangesCmds_Read_Response) Reset()         { *m = ExchangesCmds_Read_Response{} }
+func (m *ExchangesCmds_Read_Response) String() string { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_Read_Response) ProtoMessage()    {}
+func (*ExchangesCmds_Read_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{11, 2, 1}
+}
+
+type ExchangesCmds_Delete struct {
+}
+
+func (m *ExchangesCmds_Delete) Reset()                    { *m = ExchangesCmds_Delete{} }
+func (m *ExchangesCmds_Delete) String() string            { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_Delete) ProtoMessage()               {}
+func (*ExchangesCmds_Delete) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{11, 3} }
+
+type ExchangesCmds_Delete_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *ExchangesCmds_Delete_Request) Reset()         { *m = ExchangesCmds_Delete_Request{} }
+func (m *ExchangesCmds_Delete_Request) String() string { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_Delete_Request) ProtoMessage()    {}
+func (*ExchangesCmds_Delete_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{11, 3, 0}
+}
+
+type ExchangesCmds_Delete_Response struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *ExchangesCmds_Delete_Response) Reset()         { *m = ExchangesCmds_Delete_Response{} }
+func (m *ExchangesCmds_Delete_Response) String() string { return proto1.CompactTextString(m) }
+func (*ExchangesCmds_Delete_Response) ProtoMessage()    {}
+func (*ExchangesCmds_Delete_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{11, 3, 1}
+}
+
+type ExchangeBinding struct {
+	Id         string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExchangeId string                  `protobuf:"bytes,2,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	QueueId    string                  `protobuf:"bytes,3,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	Rules      []*ExchangeBinding_Rule `protobuf:"bytes,4,rep,name=rules" json:"rules,omitempty"`
+	CreatedAt  string                  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RoutingKey string                  `protobuf:"bytes,6,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+}
+
+func (m *ExchangeBinding) Reset()                    { *m = ExchangeBinding{} }
+func (m *ExchangeBinding) String() string            { return proto1.CompactTextString(m) }
+func (*ExchangeBinding) ProtoMessage()               {}
+func (*ExchangeBinding) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{12} }
+
+type ExchangeBinding_Rule struct {
+	Key      string                        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator ExchangeBinding_Rule_Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=gork_gateways_grpc.ExchangeBinding_Rule_Operator" json:"operator,omitempty"`
+	Value    string                        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ExchangeBinding_Rule) Reset()                    { *m = ExchangeBinding_Rule{} }
+func (m *ExchangeBinding_Rule) String() string            { return proto1.CompactTextString(m) }
+func (*ExchangeBinding_Rule) ProtoMessage()               {}
+func (*ExchangeBinding_Rule) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{12, 0} }
+
+// ExchangeBindingsCmds is a container that wraps request/response messages of all exchange binding-related RPC commands.
+type ExchangeBindingsCmds struct {
+}
+
+func (m *ExchangeBindingsCmds) Reset()                    { *m = ExchangeBindingsCmds{} }
+func (m *ExchangeBindingsCmds) String() string            { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds) ProtoMessage()               {}
+func (*ExchangeBindingsCmds) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{13} }
+
+type ExchangeBindingsCmds_List struct {
+}
+
+func (m *ExchangeBindingsCmds_List) Reset()         { *m = ExchangeBindingsCmds_List{} }
+func (m *ExchangeBindingsCmds_List) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_List) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_List) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 0}
+}
+
+type ExchangeBindingsCmds_List_Request struct {
+	ExchangeId string `protobuf:"bytes,1,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+}
+
+func (m *ExchangeBindingsCmds_List_Request) Reset()         { *m = ExchangeBindingsCmds_List_Request{} }
+func (m *ExchangeBindingsCmds_List_Request) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_List_Request) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_List_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 0, 0}
+}
+
+type ExchangeBindingsCmds_List_Response struct {
+	Records []*ExchangeBinding `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *ExchangeBindingsCmds_List_Response) Reset()         { *m = ExchangeBindingsCmds_List_Response{} }
+func (m *ExchangeBindingsCmds_List_Response) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_List_Response) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_List_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 0, 1}
+}
+
+type ExchangeBindingsCmds_Create struct {
+}
+
+func (m *ExchangeBindingsCmds_Create) Reset()         { *m = ExchangeBindingsCmds_Create{} }
+func (m *ExchangeBindingsCmds_Create) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_Create) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_Create) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 1}
+}
+
+type ExchangeBindingsCmds_Create_Request struct {
+	Exchange   string                  `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Queue      string                  `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Rules      []*ExchangeBinding_Rule `protobuf:"bytes,3,rep,name=rules" json:"rules,omitempty"`
+	RoutingKey string                  `protobuf:"bytes,4,opt,name=routing_key,json=routingKey,proto3" json:"routing_key,omitempty"`
+}
+
+func (m *ExchangeBindingsCmds_Create_Request) Reset()         { *m = ExchangeBindingsCmds_Create_Request{} }
+func (m *ExchangeBindingsCmds_Create_Request) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_Create_Request) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_Create_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 1, 0}
+}
+
+type ExchangeBindingsCmds_Create_Response struct {
+	Record *ExchangeBinding `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *ExchangeBindingsCmds_Create_Response) Reset()         { *m = ExchangeBindingsCmds_Create_Response{} }
+func (m *ExchangeBindingsCmds_Create_Response) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_Create_Response) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_Create_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 1, 1}
+}
+
+type ExchangeBindingsCmds_Read struct {
+}
+
+func (m *ExchangeBindingsCmds_Read) Reset()         { *m = ExchangeBindingsCmds_Read{} }
+func (m *ExchangeBindingsCmds_Read) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_Read) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_Read) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 2}
+}
+
+type ExchangeBindingsCmds_Read_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *ExchangeBindingsCmds_Read_Request) Reset()         { *m = ExchangeBindingsCmds_Read_Request{} }
+func (m *ExchangeBindingsCmds_Read_Request) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_Read_Request) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_Read_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 2, 0}
+}
+
+type ExchangeBindingsCmds_Read_Response struct {
+	Record *ExchangeBinding `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *ExchangeBindingsCmds_Read_Response) Reset()         { *m = ExchangeBindingsCmds_Read_Response{} }
+func (m *ExchangeBindingsCmds_Read_Response) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_Read_Response) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_Read_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 2, 1}
+}
+
+type ExchangeBindingsCmds_Delete struct {
+}
+
+func (m *ExchangeBindingsCmds_Delete) Reset()         { *m = ExchangeBindingsCmds_Delete{} }
+func (m *ExchangeBindingsCmds_Delete) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_Delete) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_Delete) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 3}
+}
+
+type ExchangeBindingsCmds_Delete_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *ExchangeBindingsCmds_Delete_Request) Reset()         { *m = ExchangeBindingsCmds_Delete_Request{} }
+func (m *ExchangeBindingsCmds_Delete_Request) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_Delete_Request) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_Delete_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 3, 0}
+}
+
+type ExchangeBindingsCmds_Delete_Response struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *ExchangeBindingsCmds_Delete_Response) Reset()         { *m = ExchangeBindingsCmds_Delete_Response{} }
+func (m *ExchangeBindingsCmds_Delete_Response) String() string { return proto1.CompactTextString(m) }
+func (*ExchangeBindingsCmds_Delete_Response) ProtoMessage()    {}
+func (*ExchangeBindingsCmds_Delete_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{13, 3, 1}
+}
+
+// Workflow represents a directed acyclic graph of tasks, where a task is delivered only after all its parents
+// are finished successfully.
+type Workflow struct {
+	Id        string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status    Workflow_Status  `protobuf:"varint,3,opt,name=status,proto3,enum=gork_gateways_grpc.Workflow_Status" json:"status,omitempty"`
+	Nodes     []*Workflow_Node `protobuf:"bytes,4,rep,name=nodes" json:"nodes,omitempty"`
+	Tasks     []*Task          `protobuf:"bytes,5,rep,name=tasks" json:"tasks,omitempty"`
+	CreatedAt string           `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *Workflow) Reset()                    { *m = Workflow{} }
+func (m *Workflow) String() string            { return proto1.CompactTextString(m) }
+func (*Workflow) ProtoMessage()               {}
+func (*Workflow) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{14} }
+
+type Workflow_Node struct {
+	TaskId    string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Key       string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	ParentIds []string `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds" json:"parent_ids,omitempty"`
+}
+
+func (m *Workflow_Node) Reset()                    { *m = Workflow_Node{} }
+func (m *Workflow_Node) String() string            { return proto1.CompactTextString(m) }
+func (*Workflow_Node) ProtoMessage()               {}
+func (*Workflow_Node) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{14, 0} }
+
+// WorkflowsCmds is a container that wraps request/response messages of all workflow-related RPC commands.
+type WorkflowsCmds struct {
+}
+
+func (m *WorkflowsCmds) Reset()                    { *m = WorkflowsCmds{} }
+func (m *WorkflowsCmds) String() string            { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds) ProtoMessage()               {}
+func (*WorkflowsCmds) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{15} }
+
+type WorkflowsCmds_List struct {
+}
+
+func (m *WorkflowsCmds_List) Reset()                    { *m = WorkflowsCmds_List{} }
+func (m *WorkflowsCmds_List) String() string            { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds_List) ProtoMessage()               {}
+func (*WorkflowsCmds_List) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{15, 0} }
+
+type WorkflowsCmds_List_Request struct {
+	Params *Collection_Params `protobuf:"bytes,1,opt,name=params" json:"params,omitempty"`
+}
+
+func (m *WorkflowsCmds_List_Request) Reset()         { *m = WorkflowsCmds_List_Request{} }
+func (m *WorkflowsCmds_List_Request) String() string { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds_List_Request) ProtoMessage()    {}
+func (*WorkflowsCmds_List_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{15, 0, 0}
+}
+
+type WorkflowsCmds_List_Response struct {
+	Info    *Collection_Info `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Records []*Workflow      `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *WorkflowsCmds_List_Response) Reset()         { *m = WorkflowsCmds_List_Response{} }
+func (m *WorkflowsCmds_List_Response) String() string { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds_List_Response) ProtoMessage()    {}
+func (*WorkflowsCmds_List_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{15, 0, 1}
+}
+
+type WorkflowsCmds_Create struct {
+}
+
+func (m *WorkflowsCmds_Create) Reset()                    { *m = WorkflowsCmds_Create{} }
+func (m *WorkflowsCmds_Create) String() string            { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds_Create) ProtoMessage()               {}
+func (*WorkflowsCmds_Create) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{15, 1} }
+
+type WorkflowsCmds_Create_Request struct {
+	Name  string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tasks []*WorkflowsCmds_Create_Spec `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+}
+
+func (m *WorkflowsCmds_Create_Request) Reset()         { *m = WorkflowsCmds_Create_Request{} }
+func (m *WorkflowsCmds_Create_Request) String() string { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds_Create_Request) ProtoMessage()    {}
+func (*WorkflowsCmds_Create_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{15, 1, 0}
+}
+
+type WorkflowsCmds_Create_Response struct {
+	Record *Workflow `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *WorkflowsCmds_Create_Response) Reset()         { *m = WorkflowsCmds_Create_Response{} }
+func (m *WorkflowsCmds_Create_Response) String() string { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds_Create_Response) ProtoMessage()    {}
+func (*WorkflowsCmds_Create_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{15, 1, 1}
+}
+
+// Spec describes a task of the workflow.
+type WorkflowsCmds_Create_Spec struct {
+	Key       string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Queue     string         `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Priority  uint8          `protobuf:"varint,3,opt,name=priority,proto3,casttype=uint8" json:"priority,omitempty"`
+	Headers   []*Task_Header `protobuf:"bytes,4,rep,name=headers" json:"headers,omitempty"`
+	Input     []byte         `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	DependsOn []string       `protobuf:"bytes,6,rep,name=depends_on,json=dependsOn" json:"depends_on,omitempty"`
+}
+
+func (m *WorkflowsCmds_Create_Spec) Reset()         { *m = WorkflowsCmds_Create_Spec{} }
+func (m *WorkflowsCmds_Create_Spec) String() string { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds_Create_Spec) ProtoMessage()    {}
+func (*WorkflowsCmds_Create_Spec) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{15, 1, 2}
+}
+
+type WorkflowsCmds_Read struct {
+}
+
+func (m *WorkflowsCmds_Read) Reset()                    { *m = WorkflowsCmds_Read{} }
+func (m *WorkflowsCmds_Read) String() string            { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds_Read) ProtoMessage()               {}
+func (*WorkflowsCmds_Read) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{15, 2} }
+
+type WorkflowsCmds_Read_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *WorkflowsCmds_Read_Request) Reset()         { *m = WorkflowsCmds_Read_Request{} }
+func (m *WorkflowsCmds_Read_Request) String() string { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds_Read_Request) ProtoMessage()    {}
+func (*WorkflowsCmds_Read_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{15, 2, 0}
+}
+
+type WorkflowsCmds_Read_Response struct {
+	Record *Workflow `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *WorkflowsCmds_Read_Response) Reset()         { *m = WorkflowsCmds_Read_Response{} }
+func (m *WorkflowsCmds_Read_Response) String() string { return proto1.CompactTextString(m) }
+func (*WorkflowsCmds_Read_Response) ProtoMessage()    {}
+func (*WorkflowsCmds_Read_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{15, 2, 1}
+}
+
+// Batch represents a group of tasks that are tracked together. Once every task of the batch is finished, failed
+// or cancelled, the callback task is delivered exactly once, with the summary of the batch as its input.
+// Summary is a JSON object:
+//
+//	{"batch_id": "<batch ID>", "total": 3, "succeeded": 2, "failed": 1, "results": {"<task ID>": "<output>"}}
+//
+// where `results` is present only if the batch includes results and contains the tasks that have output.
+type Batch struct {
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Total          uint32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Succeeded      uint32 `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed         uint32 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	CallbackTaskId string `protobuf:"bytes,6,opt,name=callback_task_id,json=callbackTaskId,proto3" json:"callback_task_id,omitempty"`
+	IncludeResults bool   `protobuf:"varint,7,opt,name=include_results,json=includeResults,proto3" json:"include_results,omitempty"`
+	CreatedAt      string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt     string `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (m *Batch) Reset()                    { *m = Batch{} }
+func (m *Batch) String() string            { return proto1.CompactTextString(m) }
+func (*Batch) ProtoMessage()               {}
+func (*Batch) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{16} }
+
+// BatchesCmds is a container that wraps request/response messages of all batch-related RPC commands.
+type BatchesCmds struct {
+}
+
+func (m *BatchesCmds) Reset()                    { *m = BatchesCmds{} }
+func (m *BatchesCmds) String() string            { return proto1.CompactTextString(m) }
+func (*BatchesCmds) ProtoMessage()               {}
+func (*BatchesCmds) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{17} }
+
+type BatchesCmds_List struct {
+}
+
+func (m *BatchesCmds_List) Reset()                    { *m = BatchesCmds_List{} }
+func (m *BatchesCmds_List) String() string            { return proto1.CompactTextString(m) }
+func (*BatchesCmds_List) ProtoMessage()               {}
+func (*BatchesCmds_List) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{17, 0} }
+
+type BatchesCmds_List_Request struct {
+	Params *Collection_Params `protobuf:"bytes,1,opt,name=params" json:"params,omitempty"`
+}
+
+func (m *BatchesCmds_List_Request) Reset()         { *m = BatchesCmds_List_Request{} }
+func (m *BatchesCmds_List_Request) String() string { return proto1.CompactTextString(m) }
+func (*BatchesCmds_List_Request) ProtoMessage()    {}
+func (*BatchesCmds_List_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{17, 0, 0}
+}
+
+type BatchesCmds_List_Response struct {
+	Info    *Collection_Info `protobuf:"bytes,1,opt,name=info" json:"info,omitempty"`
+	Records []*Batch         `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *BatchesCmds_List_Response) Reset()         { *m = BatchesCmds_List_Response{} }
+func (m *BatchesCmds_List_Response) String() string { return proto1.CompactTextString(m) }
+func (*BatchesCmds_List_Response) ProtoMessage()    {}
+func (*BatchesCmds_List_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{17, 0, 1}
+}
+
+type BatchesCmds_Create struct {
+}
+
+func (m *BatchesCmds_Create) Reset()                    { *m = BatchesCmds_Create{} }
+func (m *BatchesCmds_Create) String() string            { return proto1.CompactTextString(m) }
+func (*BatchesCmds_Create) ProtoMessage()               {}
+func (*BatchesCmds_Create) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{17, 1} }
+
+type BatchesCmds_Create_Request struct {
+	Name           string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tasks          []*BatchesCmds_Create_Spec `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+	Callback       *BatchesCmds_Create_Spec   `protobuf:"bytes,3,opt,name=callback" json:"callback,omitempty"`
+	IncludeResults bool                       `protobuf:"varint,4,opt,name=include_results,json=includeResults,proto3" json:"include_results,omitempty"`
+}
+
+func (m *BatchesCmds_Create_Request) Reset()         { *m = BatchesCmds_Create_Request{} }
+func (m *BatchesCmds_Create_Request) String() string { return proto1.CompactTextString(m) }
+func (*BatchesCmds_Create_Request) ProtoMessage()    {}
+func (*BatchesCmds_Create_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{17, 1, 0}
+}
+
+type BatchesCmds_Create_Response struct {
+	Record *Batch  `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+	Tasks  []*Task `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+}
+
+func (m *BatchesCmds_Create_Response) Reset()         { *m = BatchesCmds_Create_Response{} }
+func (m *BatchesCmds_Create_Response) String() string { return proto1.CompactTextString(m) }
+func (*BatchesCmds_Create_Response) ProtoMessage()    {}
+func (*BatchesCmds_Create_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{17, 1, 1}
+}
+
+// Spec describes a task of the batch, or its callback task.
+type BatchesCmds_Create_Spec struct {
+	Queue    string         `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Priority uint8          `protobuf:"varint,2,opt,name=priority,proto3,casttype=uint8" json:"priority,omitempty"`
+	Headers  []*Task_Header `protobuf:"bytes,3,rep,name=headers" json:"headers,omitempty"`
+	Input    []byte         `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (m *BatchesCmds_Create_Spec) Reset()         { *m = BatchesCmds_Create_Spec{} }
+func (m *BatchesCmds_Create_Spec) String() string { return proto1.CompactTextString(m) }
+func (*BatchesCmds_Create_Spec) ProtoMessage()    {}
+func (*BatchesCmds_Create_Spec) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{17, 1, 2}
+}
+
+type BatchesCmds_Read struct {
+}
+
+func (m *BatchesCmds_Read) Reset()                    { *m = BatchesCmds_Read{} }
+func (m *BatchesCmds_Read) String() string            { return proto1.CompactTextString(m) }
+func (*BatchesCmds_Read) ProtoMessage()               {}
+func (*BatchesCmds_Read) Descriptor() ([]byte, []int) { return fileDescriptorQueries, []int{17, 2} }
+
+type BatchesCmds_Read_Request struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *BatchesCmds_Read_Request) Reset()         { *m = BatchesCmds_Read_Request{} }
+func (m *BatchesCmds_Read_Request) String() string { return proto1.CompactTextString(m) }
+func (*BatchesCmds_Read_Request) ProtoMessage()    {}
+func (*BatchesCmds_Read_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{17, 2, 0}
+}
+
+type BatchesCmds_Read_Response struct {
+	Record *Batch `protobuf:"bytes,1,opt,name=record" json:"record,omitempty"`
+}
+
+func (m *BatchesCmds_Read_Response) Reset()         { *m = BatchesCmds_Read_Response{} }
+func (m *BatchesCmds_Read_Response) String() string { return proto1.CompactTextString(m) }
+func (*BatchesCmds_Read_Response) ProtoMessage()    {}
+func (*BatchesCmds_Read_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptorQueries, []int{17, 2, 1}
+}
+
+func init() {
+	proto1.RegisterType((*Queue)(nil), "gork_gateways_grpc.Queue")
+	proto1.RegisterType((*Queue_Setting)(nil), "gork_gateways_grpc.Queue.Setting")
+	proto1.RegisterType((*QueuesCmds)(nil), "gork_gateways_grpc.QueuesCmds")
+	proto1.RegisterType((*QueuesCmds_List)(nil), "gork_gateways_grpc.QueuesCmds.List")
+	proto1.RegisterType((*QueuesCmds_List_Request)(nil), "gork_gateways_grpc.QueuesCmds.List.Request")
+	proto1.RegisterType((*QueuesCmds_List_Response)(nil), "gork_gateways_grpc.QueuesCmds.List.Response")
+	proto1.RegisterType((*QueuesCmds_Create)(nil), "gork_gateways_grpc.QueuesCmds.Create")
+	proto1.RegisterType((*QueuesCmds_Create_Request)(nil), "gork_gateways_grpc.QueuesCmds.Create.Request")
+	proto1.RegisterType((*QueuesCmds_Create_Response)(nil), "gork_gateways_grpc.QueuesCmds.Create.Response")
+	proto1.RegisterType((*QueuesCmds_Read)(nil), "gork_gateways_grpc.QueuesCmds.Read")
+	proto1.RegisterType((*QueuesCmds_Read_Request)(nil), "gork_gateways_grpc.QueuesCmds.Read.Request")
+	proto1.RegisterType((*QueuesCmds_Read_Response)(nil), "gork_gateways_grpc.QueuesCmds.Read.Response")
+	proto1.RegisterType((*QueuesCmds_Update)(nil), "gork_gateways_grpc.QueuesCmds.Update")
+	proto1.RegisterType((*QueuesCmds_Update_Request)(nil), "gork_gateways_grpc.QueuesCmds.Update.Request")
+	proto1.RegisterType((*QueuesCmds_Update_Response)(nil), "gork_gateways_grpc.QueuesCmds.Update.Response")
+	proto1.RegisterType((*QueuesCmds_Delete)(nil), "gork_gateways_grpc.QueuesCmds.Delete")
+	proto1.RegisterType((*QueuesCmds_Delete_Request)(nil), "gork_gateways_grpc.QueuesCmds.Delete.Request")
+	proto1.RegisterType((*QueuesCmds_Delete_Response)(nil), "gork_gateways_grpc.QueuesCmds.Delete.Response")
+	proto1.RegisterType((*QueuesCmds_Pause)(nil), "gork_gateways_grpc.QueuesCmds.Pause")
+	proto1.RegisterType((*QueuesCmds_Pause_Request)(nil), "gork_gateways_grpc.QueuesCmds.Pause.Request")
+	proto1.RegisterType((*QueuesCmds_Pause_Response)(nil), "gork_gateways_grpc.QueuesCmds.Pause.Response")
+	proto1.RegisterType((*QueuesCmds_Resume)(nil), "gork_gateways_grpc.QueuesCmds.Resume")
+	proto1.RegisterType((*QueuesCmds_Resume_Request)(nil), "gork_gateways_grpc.QueuesCmds.Resume.Request")
+	proto1.RegisterType((*QueuesCmds_Resume_Response)(nil), "gork_gateways_grpc.QueuesCmds.Resume.Response")
+	proto1.RegisterType((*QueuesCmds_Purge)(nil), "gork_gateways_grpc.QueuesCmds.Purge")
+	proto1.RegisterType((*QueuesCmds_Purge_Request)(nil), "gork_gateways_grpc.QueuesCmds.Purge.Request")
+	proto1.RegisterType((*QueuesCmds_Purge_Response)(nil), "gork_gateways_grpc.QueuesCmds.Purge.Response")
+	proto1.RegisterType((*QueuesCmds_Stats)(nil), "gork_gateways_grpc.QueuesCmds.Stats")
+	proto1.RegisterType((*QueuesCmds_Stats_Request)(nil), "gork_gateways_grpc.QueuesCmds.Stats.Request")
+	proto1.RegisterType((*QueuesCmds_Stats_Response)(nil), "gork_gateways_grpc.QueuesCmds.Stats.Response")
+	proto1.RegisterType((*Task)(nil), "gork_gateways_grpc.Task")
+	proto1.RegisterType((*Task_Header)(nil), "gork_gateways_grpc.Task.Header")
+	proto1.RegisterType((*TasksCmds)(nil), "gork_gateways_grpc.TasksCmds")
+	proto1.RegisterType((*TasksCmds_Publish)(nil), "gork_gateways_grpc.TasksCmds.Publish")
+	proto1.RegisterType((*TasksCmds_Publish_Request)(nil), "gork_gateways_grpc.TasksCmds.Publish.Request")
+	proto1.RegisterType((*TasksCmds_Publish_Response)(nil), "gork_gateways_grpc.TasksCmds.Publish.Response")
+	proto1.RegisterType((*TasksCmds_Read)(nil), "gork_gateways_grpc.TasksCmds.Read")
+	proto1.RegisterType((*TasksCmds_Read_Request)(nil), "gork_gateways_grpc.TasksCmds.Read.Request")
+	proto1.RegisterType((*TasksCmds_Read_Response)(nil), "gork_gateways_grpc.TasksCmds.Read.Response")
+	proto1.RegisterType((*TasksCmds_List)(nil), "gork_gateways_grpc.TasksCmds.List")
+	proto1.RegisterType((*TasksCmds_List_Request)(nil), "gork_gateways_grpc.TasksCmds.List.Request")
+	proto1.RegisterType((*TasksCmds_List_Response)(nil), "gork_gateways_grpc.TasksCmds.List.Response")
+	proto1.RegisterType((*TasksCmds_Cancel)(nil), "gork_gateways_grpc.TasksCmds.Cancel")
+	proto1.RegisterType((*TasksCmds_Cancel_Request)(nil), "gork_gateways_grpc.TasksCmds.Cancel.Request")
+	proto1.RegisterType((*TasksCmds_Cancel_Response)(nil), "gork_gateways_grpc.TasksCmds.Cancel.Response")
+	proto1.RegisterType((*TasksCmds_CountExpired)(nil), "gork_gateways_grpc.TasksCmds.CountExpired")
+	proto1.RegisterType((*TasksCmds_CountExpired_Request)(nil), "gork_gateways_grpc.TasksCmds.CountExpired.Request")
+	proto1.RegisterType((*TasksCmds_CountExpired_Response)(nil), "gork_gateways_grpc.TasksCmds.CountExpired.Response")
+	proto1.RegisterType((*TasksCmds_GetResult)(nil), "gork_gateways_grpc.TasksCmds.GetResult")
+	proto1.RegisterType((*TasksCmds_GetResult_Request)(nil), "gork_gateways_grpc.TasksCmds.GetResult.Request")
+	proto1.RegisterType((*TasksCmds_GetResult_Response)(nil), "gork_gateways_grpc.TasksCmds.GetResult.Response")
+	proto1.RegisterType((*TasksCmds_AwaitResult)(nil), "gork_gateways_grpc.TasksCmds.AwaitResult")
+	proto1.RegisterType((*TasksCmds_AwaitResult_Request)(nil), "gork_gateways_grpc.TasksCmds.AwaitResult.Request")
+	proto1.RegisterType((*TasksCmds_AwaitResult_Response)(nil), "gork_gateways_grpc.TasksCmds.AwaitResult.Response")
+	proto1.RegisterType((*Job)(nil), "gork_gateways_grpc.Job")
+	proto1.RegisterType((*JobsCmds)(nil), "gork_gateways_grpc.JobsCmds")
+	proto1.RegisterType((*JobsCmds_Consume)(nil), "gork_gateways_grpc.JobsCmds.Consume")
+	proto1.RegisterType((*JobsCmds_Consume_Request)(nil), "gork_gateways_grpc.JobsCmds.Consume.Request")
+	proto1.RegisterType((*JobsCmds_Consume_Response)(nil), "gork_gateways_grpc.JobsCmds.Consume.Response")
+	proto1.RegisterType((*JobsCmds_Consume_Failure)(nil), "gork_gateways_grpc.JobsCmds.Consume.Failure")
+	proto1.RegisterType((*JobsCmds_Consume_Subscribe)(nil), "gork_gateways_grpc.JobsCmds.Consume.Subscribe")
+	proto1.RegisterType((*JobsCmds_Consume_Ack)(nil), "gork_gateways_grpc.JobsCmds.Consume.Ack")
+	proto1.RegisterType((*JobsCmds_Consume_Nack)(nil), "gork_gateways_grpc.JobsCmds.Consume.Nack")
+	proto1.RegisterType((*JobsCmds_Consume_Reject)(nil), "gork_gateways_grpc.JobsCmds.Consume.Reject")
+	proto1.RegisterType((*JobsCmds_Consume_Progress)(nil), "gork_gateways_grpc.JobsCmds.Consume.Progress")
+	proto1.RegisterType((*JobsCmds_Consume_Log)(nil), "gork_gateways_grpc.JobsCmds.Consume.Log")
+	proto1.RegisterType((*JobsCmds_Consume_Touch)(nil), "gork_gateways_grpc.JobsCmds.Consume.Touch")
+	proto1.RegisterType((*JobsCmds_Read)(nil), "gork_gateways_grpc.JobsCmds.Read")
+	proto1.RegisterType((*JobsCmds_Read_Request)(nil), "gork_gateways_grpc.JobsCmds.Read.Request")
+	proto1.RegisterType((*JobsCmds_Read_Response)(nil), "gork_gateways_grpc.JobsCmds.Read.Response")
+	proto1.RegisterType((*JobsCmds_Tail)(nil), "gork_gateways_grpc.JobsCmds.Tail")
+	proto1.RegisterType((*JobsCmds_Tail_Request)(nil), "gork_gateways_grpc.JobsCmds.Tail.Request")
+	proto1.RegisterType((*JobsCmds_Tail_Response)(nil), "gork_gateways_grpc.JobsCmds.Tail.Response")
+	proto1.RegisterType((*Schedule)(nil), "gork_gateways_grpc.Schedule")
+	proto1.RegisterType((*SchedulesCmds)(nil), "gork_gateways_grpc.SchedulesCmds")
+	proto1.RegisterType((*SchedulesCmds_List)(nil), "gork_gateways_grpc.SchedulesCmds.List")
+	proto1.RegisterType((*SchedulesCmds_List_Request)(nil), "gork_gateways_grpc.SchedulesCmds.List.Request")
+	proto1.RegisterType((*SchedulesCmds_List_Response)(nil), "gork_gateways_grpc.SchedulesCmds.List.Response")
+	proto1.RegisterType((*SchedulesCmds_Create)(nil), "gork_gateways_grpc.SchedulesCmds.Create")
+	proto1.RegisterType((*SchedulesCmds_Create_Request)(nil), "gork_gateways_grpc.SchedulesCmds.Create.Request")
+	proto1.RegisterType((*SchedulesCmds_Create_Response)(nil), "gork_gateways_grpc.SchedulesCmds.Create.Response")
+	proto1.RegisterType((*SchedulesCmds_Read)(nil), "gork_gateways_grpc.SchedulesCmds.Read")
+	proto1.RegisterType((*SchedulesCmds_Read_Request)(nil), "gork_gateways_grpc.SchedulesCmds.Read.Request")
+	proto1.RegisterType((*SchedulesCmds_Read_Response)(nil), "gork_gateways_grpc.SchedulesCmds.Read.Response")
+	proto1.RegisterType((*SchedulesCmds_Update)(nil), "gork_gateways_grpc.SchedulesCmds.Update")
+	proto1.RegisterType((*SchedulesCmds_Update_Request)(nil), "gork_gateways_grpc.SchedulesCmds.Update.Request")
+	proto1.RegisterType((*SchedulesCmds_Update_Response)(nil), "gork_gateways_grpc.SchedulesCmds.Update.Response")
+	proto1.RegisterType((*SchedulesCmds_Delete)(nil), "gork_gateways_grpc.SchedulesCmds.Delete")
+	proto1.RegisterType((*SchedulesCmds_Delete_Request)(nil), "gork_gateways_grpc.SchedulesCmds.Delete.Request")
+	proto1.RegisterType((*SchedulesCmds_Delete_Response)(nil), "gork_gateways_grpc.SchedulesCmds.Delete.Response")
+	proto1.RegisterType((*Worker)(nil), "gork_gateways_grpc.Worker")
+	proto1.RegisterType((*WorkersCmds)(nil), "gork_gateways_grpc.WorkersCmds")
+	proto1.RegisterType((*WorkersCmds_List)(nil), "gork_gateways_grpc.WorkersCmds.List")
+	proto1.RegisterType((*WorkersCmds_List_Request)(nil), "gork_gateways_grpc.WorkersCmds.List.Request")
+	proto1.RegisterType((*WorkersCmds_List_Response)(nil), "gork_gateways_grpc.WorkersCmds.List.Response")
+	proto1.RegisterType((*WorkersCmds_Create)(nil), "gork_gateways_grpc.WorkersCmds.Create")
+	proto1.RegisterType((*WorkersCmds_Create_Request)(nil), "gork_gateways_grpc.WorkersCmds.Create.Request")
+	proto1.RegisterType((*WorkersCmds_Create_Response)(nil), "gork_gateways_grpc.WorkersCmds.Create.Response")
+	proto1.RegisterType((*WorkersCmds_Read)(nil), "gork_gateways_grpc.WorkersCmds.Read")
+	proto1.RegisterType((*WorkersCmds_Read_Request)(nil), "gork_gateways_grpc.WorkersCmds.Read.Request")
+	proto1.RegisterType((*WorkersCmds_Read_Response)(nil), "gork_gateways_grpc.WorkersCmds.Read.Response")
+	proto1.RegisterType((*WorkersCmds_Heartbeat)(nil), "gork_gateways_grpc.WorkersCmds.Heartbeat")
+	proto1.RegisterType((*WorkersCmds_Heartbeat_Request)(nil), "gork_gateways_grpc.WorkersCmds.Heartbeat.Request")
+	proto1.RegisterType((*WorkersCmds_Heartbeat_Response)(nil), "gork_gateways_grpc.WorkersCmds.Heartbeat.Response")
+	proto1.RegisterType((*Exchange)(nil), "gork_gateways_grpc.Exchange")
+	proto1.RegisterType((*ExchangesCmds)(nil), "gork_gateways_grpc.ExchangesCmds")
+	proto1.RegisterType((*ExchangesCmds_List)(nil), "gork_gateways_grpc.ExchangesCmds.List")
+	proto1.RegisterType((*ExchangesCmds_List_Request)(nil), "gork_gateways_grpc.ExchangesCmds.List.Request")
+	proto1.RegisterType((*ExchangesCmds_List_Response)(nil), "gork_gateways_grpc.ExchangesCmds.List.Response")
+	proto1.RegisterType((*ExchangesCmds_Create)(nil), "gork_gateways_grpc.ExchangesCmds.Create")
+	proto1.RegisterType((*ExchangesCmds_Create_Request)(nil), "gork_gateways_grpc.ExchangesCmds.Create.Request")
+	proto1.RegisterType((*ExchangesCmds_Create_Response)(nil), "gork_gateways_grpc.ExchangesCmds.Create.Response")
+	proto1.RegisterType((*ExchangesCmds_Read)(nil), "gork_gateways_grpc.ExchangesCmds.Read")
+	proto1.RegisterType((*ExchangesCmds_Read_Request)(nil), "gork_gateways_grpc.ExchangesCmds.Read.Request")
+	proto1.RegisterType((*ExchangesCmds_Read_Response)(nil), "gork_gateways_grpc.ExchangesCmds.Read.Response")
+	proto1.RegisterType((*ExchangesCmds_Delete)(nil), "gork_gateways_grpc.ExchangesCmds.Delete")
+	proto1.RegisterType((*ExchangesCmds_Delete_Request)(nil), "gork_gateways_grpc.ExchangesCmds.Delete.Request")
+	proto1.RegisterType((*ExchangesCmds_Delete_Response)(nil), "gork_gateways_grpc.ExchangesCmds.Delete.Response")
+	proto1.RegisterType((*ExchangeBinding)(nil), "gork_gateways_grpc.ExchangeBinding")
+	proto1.RegisterType((*ExchangeBinding_Rule)(nil), "gork_gateways_grpc.ExchangeBinding.Rule")
+	proto1.RegisterType((*ExchangeBindingsCmds)(nil), "gork_gateways_grpc.ExchangeBindingsCmds")
+	proto1.RegisterType((*ExchangeBindingsCmds_List)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.List")
+	proto1.RegisterType((*ExchangeBindingsCmds_List_Request)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.List.Request")
+	proto1.RegisterType((*ExchangeBindingsCmds_List_Response)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.List.Response")
+	proto1.RegisterType((*ExchangeBindingsCmds_Create)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.Create")
+	proto1.RegisterType((*ExchangeBindingsCmds_Create_Request)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.Create.Request")
+	proto1.RegisterType((*ExchangeBindingsCmds_Create_Response)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.Create.Response")
+	proto1.RegisterType((*ExchangeBindingsCmds_Read)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.Read")
+	proto1.RegisterType((*ExchangeBindingsCmds_Read_Request)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.Read.Request")
+	proto1.RegisterType((*ExchangeBindingsCmds_Read_Response)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.Read.Response")
+	proto1.RegisterType((*ExchangeBindingsCmds_Delete)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.Delete")
+	proto1.RegisterType((*ExchangeBindingsCmds_Delete_Request)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.Delete.Request")
+	proto1.RegisterType((*ExchangeBindingsCmds_Delete_Response)(nil), "gork_gateways_grpc.ExchangeBindingsCmds.Delete.Response")
+	proto1.RegisterType((*Workflow)(nil), "gork_gateways_grpc.Workflow")
+	proto1.RegisterType((*Workflow_Node)(nil), "gork_gateways_grpc.Workflow.Node")
+	proto1.RegisterType((*WorkflowsCmds)(nil), "gork_gateways_grpc.WorkflowsCmds")
+	proto1.RegisterType((*WorkflowsCmds_List)(nil), "gork_gateways_grpc.WorkflowsCmds.List")
+	proto1.RegisterType((*WorkflowsCmds_List_Request)(nil), "gork_gateways_grpc.WorkflowsCmds.List.Request")
+	proto1.RegisterType((*WorkflowsCmds_List_Response)(nil), "gork_gateways_grpc.WorkflowsCmds.List.Response")
+	proto1.RegisterType((*WorkflowsCmds_Create)(nil), "gork_gateways_grpc.WorkflowsCmds.Create")
+	proto1.RegisterType((*WorkflowsCmds_Create_Request)(nil), "gork_gateways_grpc.WorkflowsCmds.Create.Request")
+	proto1.RegisterType((*WorkflowsCmds_Create_Response)(nil), "gork_gateways_grpc.WorkflowsCmds.Create.Response")
+	proto1.RegisterType((*WorkflowsCmds_Create_Spec)(nil), "gork_gateways_grpc.WorkflowsCmds.Create.Spec")
+	proto1.RegisterType((*WorkflowsCmds_Read)(nil), "gork_gateways_grpc.WorkflowsCmds.Read")
+	proto1.RegisterType((*WorkflowsCmds_Read_Request)(nil), "gork_gateways_grpc.WorkflowsCmds.Read.Request")
+	proto1.RegisterType((*WorkflowsCmds_Read_Response)(nil), "gork_gateways_grpc.WorkflowsCmds.Read.Response")
+	proto1.RegisterType((*Batch)(nil), "gork_gateways_grpc.Batch")
+	proto1.RegisterType((*BatchesCmds)(nil), "gork_gateways_grpc.BatchesCmds")
+	proto1.RegisterType((*BatchesCmds_List)(nil), "gork_gateways_grpc.BatchesCmds.List")
+	proto1.RegisterType((*BatchesCmds_List_Request)(nil), "gork_gateways_grpc.BatchesCmds.List.Request")
+	proto1.RegisterType((*BatchesCmds_List_Response)(nil), "gork_gateways_grpc.BatchesCmds.List.Response")
+	proto1.RegisterType((*BatchesCmds_Create)(nil), "gork_gateways_grpc.BatchesCmds.Create")
+	proto1.RegisterType((*BatchesCmds_Create_Request)(nil), "gork_gateways_grpc.BatchesCmds.Create.Request")
+	proto1.RegisterType((*BatchesCmds_Create_Response)(nil), "gork_gateways_grpc.BatchesCmds.Create.Response")
+	proto1.RegisterType((*BatchesCmds_Create_Spec)(nil), "gork_gateways_grpc.BatchesCmds.Create.Spec")
+	proto1.RegisterType((*BatchesCmds_Read)(nil), "gork_gateways_grpc.BatchesCmds.Read")
+	proto1.RegisterType((*BatchesCmds_Read_Request)(nil), "gork_gateways_grpc.BatchesCmds.Read.Request")
+	proto1.RegisterType((*BatchesCmds_Read_Response)(nil), "gork_gateways_grpc.BatchesCmds.Read.Response")
+	proto1.RegisterEnum("gork_gateways_grpc.Task_Status", Task_Status_name, Task_Status_value)
+	proto1.RegisterEnum("gork_gateways_grpc.Worker_Status", Worker_Status_name, Worker_Status_value)
+	proto1.RegisterEnum("gork_gateways_grpc.Exchange_Type", Exchange_Type_name, Exchange_Type_value)
+	proto1.RegisterEnum("gork_gateways_grpc.ExchangeBinding_Rule_Operator", ExchangeBinding_Rule_Operator_name, ExchangeBinding_Rule_Operator_value)
+	proto1.RegisterEnum("gork_gateways_grpc.Workflow_Status", Workflow_Status_name, Workflow_Status_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Queues service
+
+type QueuesClient interface {
+	List(ctx context.Context, in *QueuesCmds_List_Request, opts ...grpc.CallOption) (*QueuesCmds_List_Response, error)
+	Create(ctx context.Context, in *QueuesCmds_Create_Request, opts ...grpc.CallOption) (*QueuesCmds_Create_Response, error)
+	Read(ctx context.Context, in *QueuesCmds_Read_Request, opts ...grpc.CallOption) (*QueuesCmds_Read_Response, error)
+	Update(ctx context.Context, in *QueuesCmds_Update_Request, opts ...grpc.CallOption) (*QueuesCmds_Update_Response, error)
+	Delete(ctx context.Context, in *QueuesCmds_Delete_Request, opts ...grpc.CallOption) (*QueuesCmds_Delete_Response, error)
+	Pause(ctx context.Context, in *QueuesCmds_Pause_Request, opts ...grpc.CallOption) (*QueuesCmds_Pause_Response, error)
+	Resume(ctx context.Context, in *QueuesCmds_Resume_Request, opts ...grpc.CallOption) (*QueuesCmds_Resume_Response, error)
+	Purge(ctx context.Context, in *QueuesCmds_Purge_Request, opts ...grpc.CallOption) (*QueuesCmds_Purge_Response, error)
+	Stats(ctx context.Context, in *QueuesCmds_Stats_Request, opts ...grpc.CallOption) (*QueuesCmds_Stats_Response, error)
+}
+
+type queuesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueuesClient(cc *grpc.ClientConn) QueuesClient {
+	return &queuesClient{cc}
+}
+
+func (c *queuesClient) List(ctx context.Context, in *QueuesCmds_List_Request, opts ...grpc.CallOption) (*QueuesCmds_List_Response, error) {
+	out := new(QueuesCmds_List_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queuesClient) Create(ctx context.Context, in *QueuesCmds_Create_Request, opts ...grpc.CallOption) (*QueuesCmds_Create_Response, error) {
+	out := new(QueuesCmds_Create_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queuesClient) Read(ctx context.Context, in *QueuesCmds_Read_Request, opts ...grpc.CallOption) (*QueuesCmds_Read_Response, error) {
+	out := new(QueuesCmds_Read_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queuesClient) Update(ctx context.Context, in *QueuesCmds_Update_Request, opts ...grpc.CallOption) (*QueuesCmds_Update_Response, error) {
+	out := new(QueuesCmds_Update_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queuesClient) Delete(ctx context.Context, in *QueuesCmds_Delete_Request, opts ...grpc.CallOption) (*QueuesCmds_Delete_Response, error) {
+	out := new(QueuesCmds_Delete_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queuesClient) Pause(ctx context.Context, in *QueuesCmds_Pause_Request, opts ...grpc.CallOption) (*QueuesCmds_Pause_Response, error) {
+	out := new(QueuesCmds_Pause_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Pause", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queuesClient) Resume(ctx context.Context, in *QueuesCmds_Resume_Request, opts ...grpc.CallOption) (*QueuesCmds_Resume_Response, error) {
+	out := new(QueuesCmds_Resume_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Resume", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queuesClient) Purge(ctx context.Context, in *QueuesCmds_Purge_Request, opts ...grpc.CallOption) (*QueuesCmds_Purge_Response, error) {
+	out := new(QueuesCmds_Purge_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Purge", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queuesClient) Stats(ctx context.Context, in *QueuesCmds_Stats_Request, opts ...grpc.CallOption) (*QueuesCmds_Stats_Response, error) {
+	out := new(QueuesCmds_Stats_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Queues/Stats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Queues service
+
+type QueuesServer interface {
+	List(context.Context, *QueuesCmds_List_Request) (*QueuesCmds_List_Response, error)
+	Create(context.Context, *QueuesCmds_Create_Request) (*QueuesCmds_Create_Response, error)
+	Read(context.Context, *QueuesCmds_Read_Request) (*QueuesCmds_Read_Response, error)
+	Update(context.Context, *QueuesCmds_Update_Request) (*QueuesCmds_Update_Response, error)
+	Delete(context.Context, *QueuesCmds_Delete_Request) (*QueuesCmds_Delete_Response, error)
+	Pause(context.Context, *QueuesCmds_Pause_Request) (*QueuesCmds_Pause_Response, error)
+	Resume(context.Context, *QueuesCmds_Resume_Request) (*QueuesCmds_Resume_Response, error)
+	Purge(context.Context, *QueuesCmds_Purge_Request) (*QueuesCmds_Purge_Response, error)
+	Stats(context.Context, *QueuesCmds_Stats_Request) (*QueuesCmds_Stats_Response, error)
+}
+
+func RegisterQueuesServer(s *grpc.Server, srv QueuesServer) {
+	s.RegisterService(&_Queues_serviceDesc, srv)
+}
+
+func _Queues_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuesCmds_List_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Queues/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).List(ctx, req.(*QueuesCmds_List_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queues_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuesCmds_Create_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Queues/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).Create(ctx, req.(*QueuesCmds_Create_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queues_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuesCmds_Read_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Queues/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).Read(ctx, req.(*QueuesCmds_Read_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queues_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuesCmds_Update_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Queues/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).Update(ctx, req.(*QueuesCmds_Update_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queues_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuesCmds_Delete_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Queues/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).Delete(ctx, req.(*QueuesCmds_Delete_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queues_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuesCmds_Pause_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Queues/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).Pause(ctx, req.(*QueuesCmds_Pause_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queues_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuesCmds_Resume_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Queues/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).Resume(ctx, req.(*QueuesCmds_Resume_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queues_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuesCmds_Purge_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Queues/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).Purge(ctx, req.(*QueuesCmds_Purge_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queues_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuesCmds_Stats_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueuesServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Queues/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueuesServer).Stats(ctx, req.(*QueuesCmds_Stats_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Queues_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gork_gateways_grpc.Queues",
+	HandlerType: (*QueuesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Queues_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Queues_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Queues_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Queues_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Queues_Delete_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Queues_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Queues_Resume_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _Queues_Purge_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Queues_Stats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "queries.proto",
+}
+
+// Client API for Tasks service
+
+type TasksClient interface {
+	Publish(ctx context.Context, in *TasksCmds_Publish_Request, opts ...grpc.CallOption) (*TasksCmds_Publish_Response, error)
+	Read(ctx context.Context, in *TasksCmds_Read_Request, opts ...grpc.CallOption) (*TasksCmds_Read_Response, error)
+	List(ctx context.Context, in *TasksCmds_List_Request, opts ...grpc.CallOption) (*TasksCmds_List_Response, error)
+	Cancel(ctx context.Context, in *TasksCmds_Cancel_Request, opts ...grpc.CallOption) (*TasksCmds_Cancel_Response, error)
+	CountExpired(ctx context.Context, in *TasksCmds_CountExpired_Request, opts ...grpc.CallOption) (*TasksCmds_CountExpired_Response, error)
+	GetResult(ctx context.Context, in *TasksCmds_GetResult_Request, opts ...grpc.CallOption) (*TasksCmds_GetResult_Response, error)
+	AwaitResult(ctx context.Context, in *TasksCmds_AwaitResult_Request, opts ...grpc.CallOption) (*TasksCmds_AwaitResult_Response, error)
+}
+
+type tasksClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTasksClient(cc *grpc.ClientConn) TasksClient {
+	return &tasksClient{cc}
+}
+
+func (c *tasksClient) Publish(ctx context.Context, in *TasksCmds_Publish_Request, opts ...grpc.CallOption) (*TasksCmds_Publish_Response, error) {
+	out := new(TasksCmds_Publish_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Tasks/Publish", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) Read(ctx context.Context, in *TasksCmds_Read_Request, opts ...grpc.CallOption) (*TasksCmds_Read_Response, error) {
+	out := new(TasksCmds_Read_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Tasks/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) List(ctx context.Context, in *TasksCmds_List_Request, opts ...grpc.CallOption) (*TasksCmds_List_Response, error) {
+	out := new(TasksCmds_List_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Tasks/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) Cancel(ctx context.Context, in *TasksCmds_Cancel_Request, opts ...grpc.CallOption) (*TasksCmds_Cancel_Response, error) {
+	out := new(TasksCmds_Cancel_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Tasks/Cancel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) CountExpired(ctx context.Context, in *TasksCmds_CountExpired_Request, opts ...grpc.CallOption) (*TasksCmds_CountExpired_Response, error) {
+	out := new(TasksCmds_CountExpired_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Tasks/CountExpired", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) GetResult(ctx context.Context, in *TasksCmds_GetResult_Request, opts ...grpc.CallOption) (*TasksCmds_GetResult_Response, error) {
+	out := new(TasksCmds_GetResult_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Tasks/GetResult", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) AwaitResult(ctx context.Context, in *TasksCmds_AwaitResult_Request, opts ...grpc.CallOption) (*TasksCmds_AwaitResult_Response, error) {
+	out := new(TasksCmds_AwaitResult_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Tasks/AwaitResult", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Tasks service
+
+type TasksServer interface {
+	Publish(context.Context, *TasksCmds_Publish_Request) (*TasksCmds_Publish_Response, error)
+	Read(context.Context, *TasksCmds_Read_Request) (*TasksCmds_Read_Response, error)
+	List(context.Context, *TasksCmds_List_Request) (*TasksCmds_List_Response, error)
+	Cancel(context.Context, *TasksCmds_Cancel_Request) (*TasksCmds_Cancel_Response, error)
+	CountExpired(context.Context, *TasksCmds_CountExpired_Request) (*TasksCmds_CountExpired_Response, error)
+	GetResult(context.Context, *TasksCmds_GetResult_Request) (*TasksCmds_GetResult_Response, error)
+	AwaitResult(context.Context, *TasksCmds_AwaitResult_Request) (*TasksCmds_AwaitResult_Response, error)
+}
+
+func RegisterTasksServer(s *grpc.Server, srv TasksServer) {
+	s.RegisterService(&_Tasks_serviceDesc, srv)
+}
+
+func _Tasks_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksCmds_Publish_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Tasks/Publish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).Publish(ctx, req.(*TasksCmds_Publish_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksCmds_Read_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Tasks/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).Read(ctx, req.(*TasksCmds_Read_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksCmds_List_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Tasks/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).List(ctx, req.(*TasksCmds_List_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksCmds_Cancel_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Tasks/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).Cancel(ctx, req.(*TasksCmds_Cancel_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_CountExpired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksCmds_CountExpired_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).CountExpired(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Tasks/CountExpired",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).CountExpired(ctx, req.(*TasksCmds_CountExpired_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_GetResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksCmds_GetResult_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).GetResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Tasks/GetResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).GetResult(ctx, req.(*TasksCmds_GetResult_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_AwaitResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksCmds_AwaitResult_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).AwaitResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Tasks/AwaitResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).AwaitResult(ctx, req.(*TasksCmds_AwaitResult_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Tasks_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gork_gateways_grpc.Tasks",
+	HandlerType: (*TasksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Publish",
+			Handler:    _Tasks_Publish_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Tasks_Read_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Tasks_List_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Tasks_Cancel_Handler,
+		},
+		{
+			MethodName: "CountExpired",
+			Handler:    _Tasks_CountExpired_Handler,
+		},
+		{
+			MethodName: "GetResult",
+			Handler:    _Tasks_GetResult_Handler,
+		},
+		{
+			MethodName: "AwaitResult",
+			Handler:    _Tasks_AwaitResult_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "queries.proto",
+}
+
+// Client API for Jobs service
+
+type JobsClient interface {
+	Consume(ctx context.Context, opts ...grpc.CallOption) (Jobs_ConsumeClient, error)
+	Read(ctx context.Context, in *JobsCmds_Read_Request, opts ...grpc.CallOption) (*JobsCmds_Read_Response, error)
+	Tail(ctx context.Context, in *JobsCmds_Tail_Request, opts ...grpc.CallOption) (Jobs_TailClient, error)
+}
+
+type jobsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewJobsClient(cc *grpc.ClientConn) JobsClient {
+	return &jobsClient{cc}
+}
+
+func (c *jobsClient) Consume(ctx context.Context, opts ...grpc.CallOption) (Jobs_ConsumeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Jobs_serviceDesc.Streams[0], c.cc, "/gork_gateways_grpc.Jobs/Consume", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobsConsumeClient{stream}
+	return x, nil
+}
+
+type Jobs_ConsumeClient interface {
+	Send(*JobsCmds_Consume_Request) error
+	Recv() (*JobsCmds_Consume_Response, error)
+	grpc.ClientStream
+}
+
+type jobsConsumeClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobsConsumeClient) Send(m *JobsCmds_Consume_Request) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *jobsConsumeClient) Recv() (*JobsCmds_Consume_Response, error) {
+	m := new(JobsCmds_Consume_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *jobsClient) Read(ctx context.Context, in *JobsCmds_Read_Request, opts ...grpc.CallOption) (*JobsCmds_Read_Response, error) {
+	out := new(JobsCmds_Read_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Jobs/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobsClient) Tail(ctx context.Context, in *JobsCmds_Tail_Request, opts ...grpc.CallOption) (Jobs_TailClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Jobs_serviceDesc.Streams[1], c.cc, "/gork_gateways_grpc.Jobs/Tail", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &jobsTailClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Jobs_TailClient interface {
+	Recv() (*JobsCmds_Tail_Response, error)
+	grpc.ClientStream
+}
+
+type jobsTailClient struct {
+	grpc.ClientStream
+}
+
+func (x *jobsTailClient) Recv() (*JobsCmds_Tail_Response, error) {
+	m := new(JobsCmds_Tail_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Jobs service
+
+type JobsServer interface {
+	Consume(Jobs_ConsumeServer) error
+	Read(context.Context, *JobsCmds_Read_Request) (*JobsCmds_Read_Response, error)
+	Tail(*JobsCmds_Tail_Request, Jobs_TailServer) error
+}
+
+func RegisterJobsServer(s *grpc.Server, srv JobsServer) {
+	s.RegisterService(&_Jobs_serviceDesc, srv)
+}
+
+func _Jobs_Consume_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JobsServer).Consume(&jobsConsumeServer{stream})
+}
+
+type Jobs_ConsumeServer interface {
+	Send(*JobsCmds_Consume_Response) error
+	Recv() (*JobsCmds_Consume_Request, error)
+	grpc.ServerStream
+}
+
+type jobsConsumeServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobsConsumeServer) Send(m *JobsCmds_Consume_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *jobsConsumeServer) Recv() (*JobsCmds_Consume_Request, error) {
+	m := new(JobsCmds_Consume_Request)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Jobs_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobsCmds_Read_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobsServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Jobs/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobsServer).Read(ctx, req.(*JobsCmds_Read_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Jobs_Tail_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JobsCmds_Tail_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobsServer).Tail(m, &jobsTailServer{stream})
+}
+
+type Jobs_TailServer interface {
+	Send(*JobsCmds_Tail_Response) error
+	grpc.ServerStream
+}
+
+type jobsTailServer struct {
+	grpc.ServerStream
+}
+
+func (x *jobsTailServer) Send(m *JobsCmds_Tail_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Jobs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gork_gateways_grpc.Jobs",
+	HandlerType: (*JobsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
+			Handler:    _Jobs_Read_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Consume",
+			Handler:       _Jobs_Consume_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Tail",
+			Handler:       _Jobs_Tail_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "queries.proto",
+}
+
+// Client API for Schedules service
+
+type SchedulesClient interface {
+	List(ctx context.Context, in *SchedulesCmds_List_Request, opts ...grpc.CallOption) (*SchedulesCmds_List_Response, error)
+	Create(ctx context.Context, in *SchedulesCmds_Create_Request, opts ...grpc.CallOption) (*SchedulesCmds_Create_Response, error)
+	Read(ctx context.Context, in *SchedulesCmds_Read_Request, opts ...grpc.CallOption) (*SchedulesCmds_Read_Response, error)
+	Update(ctx context.Context, in *SchedulesCmds_Update_Request, opts ...grpc.CallOption) (*SchedulesCmds_Update_Response, error)
+	Delete(ctx context.Context, in *SchedulesCmds_Delete_Request, opts ...grpc.CallOption) (*SchedulesCmds_Delete_Response, error)
+}
+
+type schedulesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSchedulesClient(cc *grpc.ClientConn) SchedulesClient {
+	return &schedulesClient{cc}
+}
+
+func (c *schedulesClient) List(ctx context.Context, in *SchedulesCmds_List_Request, opts ...grpc.CallOption) (*SchedulesCmds_List_Response, error) {
+	out := new(SchedulesCmds_List_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Schedules/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulesClient) Create(ctx context.Context, in *SchedulesCmds_Create_Request, opts ...grpc.CallOption) (*SchedulesCmds_Create_Response, error) {
+	out := new(SchedulesCmds_Create_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Schedules/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulesClient) Read(ctx context.Context, in *SchedulesCmds_Read_Request, opts ...grpc.CallOption) (*SchedulesCmds_Read_Response, error) {
+	out := new(SchedulesCmds_Read_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Schedules/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulesClient) Update(ctx context.Context, in *SchedulesCmds_Update_Request, opts ...grpc.CallOption) (*SchedulesCmds_Update_Response, error) {
+	out := new(SchedulesCmds_Update_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Schedules/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulesClient) Delete(ctx context.Context, in *SchedulesCmds_Delete_Request, opts ...grpc.CallOption) (*SchedulesCmds_Delete_Response, error) {
+	out := new(SchedulesCmds_Delete_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Schedules/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Schedules service
+
+type SchedulesServer interface {
+	List(context.Context, *SchedulesCmds_List_Request) (*SchedulesCmds_List_Response, error)
+	Create(context.Context, *SchedulesCmds_Create_Request) (*SchedulesCmds_Create_Response, error)
+	Read(context.Context, *SchedulesCmds_Read_Request) (*SchedulesCmds_Read_Response, error)
+	Update(context.Context, *SchedulesCmds_Update_Request) (*SchedulesCmds_Update_Response, error)
+	Delete(context.Context, *SchedulesCmds_Delete_Request) (*SchedulesCmds_Delete_Response, error)
+}
+
+func RegisterSchedulesServer(s *grpc.Server, srv SchedulesServer) {
+	s.RegisterService(&_Schedules_serviceDesc, srv)
+}
+
+func _Schedules_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulesCmds_List_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Schedules/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulesServer).List(ctx, req.(*SchedulesCmds_List_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schedules_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulesCmds_Create_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulesServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Schedules/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulesServer).Create(ctx, req.(*SchedulesCmds_Create_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schedules_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulesCmds_Read_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulesServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Schedules/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulesServer).Read(ctx, req.(*SchedulesCmds_Read_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schedules_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulesCmds_Update_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulesServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Schedules/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulesServer).Update(ctx, req.(*SchedulesCmds_Update_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Schedules_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulesCmds_Delete_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulesServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Schedules/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulesServer).Delete(ctx, req.(*SchedulesCmds_Delete_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Schedules_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gork_gateways_grpc.Schedules",
+	HandlerType: (*SchedulesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Schedules_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Schedules_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Schedules_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Schedules_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Schedules_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "queries.proto",
+}
+
+// Client API for Exchanges service
+
+type ExchangesClient interface {
+	List(ctx context.Context, in *ExchangesCmds_List_Request, opts ...grpc.CallOption) (*ExchangesCmds_List_Response, error)
+	Create(ctx context.Context, in *ExchangesCmds_Create_Request, opts ...grpc.CallOption) (*ExchangesCmds_Create_Response, error)
+	Read(ctx context.Context, in *ExchangesCmds_Read_Request, opts ...grpc.CallOption) (*ExchangesCmds_Read_Response, error)
+	Delete(ctx context.Context, in *ExchangesCmds_Delete_Request, opts ...grpc.CallOption) (*ExchangesCmds_Delete_Response, error)
+}
+
+type exchangesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewExchangesClient(cc *grpc.ClientConn) ExchangesClient {
+	return &exchangesClient{cc}
+}
+
+func (c *exchangesClient) List(ctx context.Context, in *ExchangesCmds_List_Request, opts ...grpc.CallOption) (*ExchangesCmds_List_Response, error) {
+	out := new(ExchangesCmds_List_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Exchanges/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangesClient) Create(ctx context.Context, in *ExchangesCmds_Create_Request, opts ...grpc.CallOption) (*ExchangesCmds_Create_Response, error) {
+	out := new(ExchangesCmds_Create_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Exchanges/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangesClient) Read(ctx context.Context, in *ExchangesCmds_Read_Request, opts ...grpc.CallOption) (*ExchangesCmds_Read_Response, error) {
+	out := new(ExchangesCmds_Read_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Exchanges/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangesClient) Delete(ctx context.Context, in *ExchangesCmds_Delete_Request, opts ...grpc.CallOption) (*ExchangesCmds_Delete_Response, error) {
+	out := new(ExchangesCmds_Delete_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Exchanges/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Exchanges service
+
+type ExchangesServer interface {
+	List(context.Context, *ExchangesCmds_List_Request) (*ExchangesCmds_List_Response, error)
+	Create(context.Context, *ExchangesCmds_Create_Request) (*ExchangesCmds_Create_Response, error)
+	Read(context.Context, *ExchangesCmds_Read_Request) (*ExchangesCmds_Read_Response, error)
+	Delete(context.Context, *ExchangesCmds_Delete_Request) (*ExchangesCmds_Delete_Response, error)
+}
+
+func RegisterExchangesServer(s *grpc.Server, srv ExchangesServer) {
+	s.RegisterService(&_Exchanges_serviceDesc, srv)
+}
+
+func _Exchanges_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangesCmds_List_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Exchanges/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangesServer).List(ctx, req.(*ExchangesCmds_List_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchanges_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangesCmds_Create_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangesServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Exchanges/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangesServer).Create(ctx, req.(*ExchangesCmds_Create_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchanges_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangesCmds_Read_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangesServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Exchanges/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangesServer).Read(ctx, req.(*ExchangesCmds_Read_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Exchanges_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangesCmds_Delete_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangesServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Exchanges/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangesServer).Delete(ctx, req.(*ExchangesCmds_Delete_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Exchanges_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gork_gateways_grpc.Exchanges",
+	HandlerType: (*ExchangesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Exchanges_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Exchanges_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Exchanges_Read_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Exchanges_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "queries.proto",
+}
+
+// Client API for ExchangeBindings service
+
+type ExchangeBindingsClient interface {
+	List(ctx context.Context, in *ExchangeBindingsCmds_List_Request, opts ...grpc.CallOption) (*ExchangeBindingsCmds_List_Response, error)
+	Create(ctx context.Context, in *ExchangeBindingsCmds_Create_Request, opts ...grpc.CallOption) (*ExchangeBindingsCmds_Create_Response, error)
+	Read(ctx context.Context, in *ExchangeBindingsCmds_Read_Request, opts ...grpc.CallOption) (*ExchangeBindingsCmds_Read_Response, error)
+	Delete(ctx context.Context, in *ExchangeBindingsCmds_Delete_Request, opts ...grpc.CallOption) (*ExchangeBindingsCmds_Delete_Response, error)
+}
+
+type exchangeBindingsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewExchangeBindingsClient(cc *grpc.ClientConn) ExchangeBindingsClient {
+	return &exchangeBindingsClient{cc}
+}
+
+func (c *exchangeBindingsClient) List(ctx context.Context, in *ExchangeBindingsCmds_List_Request, opts ...grpc.CallOption) (*ExchangeBindingsCmds_List_Response, error) {
+	out := new(ExchangeBindingsCmds_List_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.ExchangeBindings/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeBindingsClient) Create(ctx context.Context, in *ExchangeBindingsCmds_Create_Request, opts ...grpc.CallOption) (*ExchangeBindingsCmds_Create_Response, error) {
+	out := new(ExchangeBindingsCmds_Create_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.ExchangeBindings/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeBindingsClient) Read(ctx context.Context, in *ExchangeBindingsCmds_Read_Request, opts ...grpc.CallOption) (*ExchangeBindingsCmds_Read_Response, error) {
+	out := new(ExchangeBindingsCmds_Read_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.ExchangeBindings/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeBindingsClient) Delete(ctx context.Context, in *ExchangeBindingsCmds_Delete_Request, opts ...grpc.CallOption) (*ExchangeBindingsCmds_Delete_Response, error) {
+	out := new(ExchangeBindingsCmds_Delete_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.ExchangeBindings/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ExchangeBindings service
+
+type ExchangeBindingsServer interface {
+	List(context.Context, *ExchangeBindingsCmds_List_Request) (*ExchangeBindingsCmds_List_Response, error)
+	Create(context.Context, *ExchangeBindingsCmds_Create_Request) (*ExchangeBindingsCmds_Create_Response, error)
+	Read(context.Context, *ExchangeBindingsCmds_Read_Request) (*ExchangeBindingsCmds_Read_Response, error)
+	Delete(context.Context, *ExchangeBindingsCmds_Delete_Request) (*ExchangeBindingsCmds_Delete_Response, error)
+}
+
+func RegisterExchangeBindingsServer(s *grpc.Server, srv ExchangeBindingsServer) {
+	s.RegisterService(&_ExchangeBindings_serviceDesc, srv)
+}
+
+func _ExchangeBindings_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeBindingsCmds_List_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeBindingsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.ExchangeBindings/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeBindingsServer).List(ctx, req.(*ExchangeBindingsCmds_List_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeBindings_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeBindingsCmds_Create_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeBindingsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.ExchangeBindings/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeBindingsServer).Create(ctx, req.(*ExchangeBindingsCmds_Create_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeBindings_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeBindingsCmds_Read_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeBindingsServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.ExchangeBindings/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeBindingsServer).Read(ctx, req.(*ExchangeBindingsCmds_Read_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeBindings_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeBindingsCmds_Delete_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeBindingsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.ExchangeBindings/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeBindingsServer).Delete(ctx, req.(*ExchangeBindingsCmds_Delete_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ExchangeBindings_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gork_gateways_grpc.ExchangeBindings",
+	HandlerType: (*ExchangeBindingsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _ExchangeBindings_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ExchangeBindings_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _ExchangeBindings_Read_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ExchangeBindings_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "queries.proto",
+}
+
+// Client API for Workers service
+
+type WorkersClient interface {
+	List(ctx context.Context, in *WorkersCmds_List_Request, opts ...grpc.CallOption) (*WorkersCmds_List_Response, error)
+	Create(ctx context.Context, in *WorkersCmds_Create_Request, opts ...grpc.CallOption) (*WorkersCmds_Create_Response, error)
+	Read(ctx context.Context, in *WorkersCmds_Read_Request, opts ...grpc.CallOption) (*WorkersCmds_Read_Response, error)
+	Heartbeat(ctx context.Context, in *WorkersCmds_Heartbeat_Request, opts ...grpc.CallOption) (*WorkersCmds_Heartbeat_Response, error)
+}
+
+type workersClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkersClient(cc *grpc.ClientConn) WorkersClient {
+	return &workersClient{cc}
+}
+
+func (c *workersClient) List(ctx context.Context, in *WorkersCmds_List_Request, opts ...grpc.CallOption) (*WorkersCmds_List_Response, error) {
+	out := new(WorkersCmds_List_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Workers/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workersClient) Create(ctx context.Context, in *WorkersCmds_Create_Request, opts ...grpc.CallOption) (*WorkersCmds_Create_Response, error) {
+	out := new(WorkersCmds_Create_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Workers/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workersClient) Read(ctx context.Context, in *WorkersCmds_Read_Request, opts ...grpc.CallOption) (*WorkersCmds_Read_Response, error) {
+	out := new(WorkersCmds_Read_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Workers/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workersClient) Heartbeat(ctx context.Context, in *WorkersCmds_Heartbeat_Request, opts ...grpc.CallOption) (*WorkersCmds_Heartbeat_Response, error) {
+	out := new(WorkersCmds_Heartbeat_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Workers/Heartbeat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Workers service
+
+type WorkersServer interface {
+	List(context.Context, *WorkersCmds_List_Request) (*WorkersCmds_List_Response, error)
+	Create(context.Context, *WorkersCmds_Create_Request) (*WorkersCmds_Create_Response, error)
+	Read(context.Context, *WorkersCmds_Read_Request) (*WorkersCmds_Read_Response, error)
+	Heartbeat(context.Context, *WorkersCmds_Heartbeat_Request) (*WorkersCmds_Heartbeat_Response, error)
+}
+
+func RegisterWorkersServer(s *grpc.Server, srv WorkersServer) {
+	s.RegisterService(&_Workers_serviceDesc, srv)
+}
+
+func _Workers_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkersCmds_List_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Workers/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServer).List(ctx, req.(*WorkersCmds_List_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Workers_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkersCmds_Create_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Workers/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServer).Create(ctx, req.(*WorkersCmds_Create_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Workers_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkersCmds_Read_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Workers/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServer).Read(ctx, req.(*WorkersCmds_Read_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Workers_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkersCmds_Heartbeat_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkersServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Workers/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkersServer).Heartbeat(ctx, req.(*WorkersCmds_Heartbeat_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Workers_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gork_gateways_grpc.Workers",
+	HandlerType: (*WorkersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Workers_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Workers_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Workers_Read_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Workers_Heartbeat_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "queries.proto",
+}
+
+// Client API for Workflows service
+
+type WorkflowsClient interface {
+	List(ctx context.Context, in *WorkflowsCmds_List_Request, opts ...grpc.CallOption) (*WorkflowsCmds_List_Response, error)
+	Create(ctx context.Context, in *WorkflowsCmds_Create_Request, opts ...grpc.CallOption) (*WorkflowsCmds_Create_Response, error)
+	Read(ctx context.Context, in *WorkflowsCmds_Read_Request, opts ...grpc.CallOption) (*WorkflowsCmds_Read_Response, error)
+}
+
+type workflowsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkflowsClient(cc *grpc.ClientConn) WorkflowsClient {
+	return &workflowsClient{cc}
+}
+
+func (c *workflowsClient) List(ctx context.Context, in *WorkflowsCmds_List_Request, opts ...grpc.CallOption) (*WorkflowsCmds_List_Response, error) {
+	out := new(WorkflowsCmds_List_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Workflows/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowsClient) Create(ctx context.Context, in *WorkflowsCmds_Create_Request, opts ...grpc.CallOption) (*WorkflowsCmds_Create_Response, error) {
+	out := new(WorkflowsCmds_Create_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Workflows/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowsClient) Read(ctx context.Context, in *WorkflowsCmds_Read_Request, opts ...grpc.CallOption) (*WorkflowsCmds_Read_Response, error) {
+	out := new(WorkflowsCmds_Read_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Workflows/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Workflows service
+
+type WorkflowsServer interface {
+	List(context.Context, *WorkflowsCmds_List_Request) (*WorkflowsCmds_List_Response, error)
+	Create(context.Context, *WorkflowsCmds_Create_Request) (*WorkflowsCmds_Create_Response, error)
+	Read(context.Context, *WorkflowsCmds_Read_Request) (*WorkflowsCmds_Read_Response, error)
+}
+
+func RegisterWorkflowsServer(s *grpc.Server, srv WorkflowsServer) {
+	s.RegisterService(&_Workflows_serviceDesc, srv)
+}
+
+func _Workflows_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowsCmds_List_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Workflows/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowsServer).List(ctx, req.(*WorkflowsCmds_List_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Workflows_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowsCmds_Create_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Workflows/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowsServer).Create(ctx, req.(*WorkflowsCmds_Create_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Workflows_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowsCmds_Read_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowsServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Workflows/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowsServer).Read(ctx, req.(*WorkflowsCmds_Read_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Workflows_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gork_gateways_grpc.Workflows",
+	HandlerType: (*WorkflowsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Workflows_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Workflows_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Workflows_Read_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "queries.proto",
+}
+
+// Client API for Batches service
+
+type BatchesClient interface {
+	List(ctx context.Context, in *BatchesCmds_List_Request, opts ...grpc.CallOption) (*BatchesCmds_List_Response, error)
+	Create(ctx context.Context, in *BatchesCmds_Create_Request, opts ...grpc.CallOption) (*BatchesCmds_Create_Response, error)
+	Read(ctx context.Context, in *BatchesCmds_Read_Request, opts ...grpc.CallOption) (*BatchesCmds_Read_Response, error)
+}
+
+type batchesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBatchesClient(cc *grpc.ClientConn) BatchesClient {
+	return &batchesClient{cc}
+}
+
+func (c *batchesClient) List(ctx context.Context, in *BatchesCmds_List_Request, opts ...grpc.CallOption) (*BatchesCmds_List_Response, error) {
+	out := new(BatchesCmds_List_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Batches/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *batchesClient) Create(ctx context.Context, in *BatchesCmds_Create_Request, opts ...grpc.CallOption) (*BatchesCmds_Create_Response, error) {
+	out := new(BatchesCmds_Create_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Batches/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *batchesClient) Read(ctx context.Context, in *BatchesCmds_Read_Request, opts ...grpc.CallOption) (*BatchesCmds_Read_Response, error) {
+	out := new(BatchesCmds_Read_Response)
+	err := grpc.Invoke(ctx, "/gork_gateways_grpc.Batches/Read", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Batches service
+
+type BatchesServer interface {
+	List(context.Context, *BatchesCmds_List_Request) (*BatchesCmds_List_Response, error)
+	Create(context.Context, *BatchesCmds_Create_Request) (*BatchesCmds_Create_Response, error)
+	Read(context.Context, *BatchesCmds_Read_Request) (*BatchesCmds_Read_Response, error)
+}
+
+func RegisterBatchesServer(s *grpc.Server, srv BatchesServer) {
+	s.RegisterService(&_Batches_serviceDesc, srv)
+}
+
+func _Batches_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchesCmds_List_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Batches/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchesServer).List(ctx, req.(*BatchesCmds_List_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Batches_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchesCmds_Create_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchesServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Batches/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchesServer).Create(ctx, req.(*BatchesCmds_Create_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Batches_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchesCmds_Read_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchesServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gork_gateways_grpc.Batches/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchesServer).Read(ctx, req.(*BatchesCmds_Read_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Batches_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gork_gateways_grpc.Batches",
+	HandlerType: (*BatchesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Batches_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Batches_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _Batches_Read_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "queries.proto",
+}
+
+func (m *Queue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Settings) > 0 {
+		for _, msg := range m.Settings {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.CreatedAt) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CreatedAt)))
+		i += copy(dAtA[i:], m.CreatedAt)
+	}
+	if m.Paused {
+		dAtA[i] = 0x28
+		i++
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *Queue_Setting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Queue_Setting) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *QueuesCmds_List) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_List) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *QueuesCmds_List_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_List_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Params.Size()))
+		n1, err := m.Params.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_List_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_List_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Info.Size()))
+		n2, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Create) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Create) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *QueuesCmds_Create_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Create_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Settings) > 0 {
+		for _, msg := range m.Settings {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Create_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Create_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n3, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Read) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Read) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *QueuesCmds_Read_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Read_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Read_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Read_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n4, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Update) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Update) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *QueuesCmds_Update_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Update_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Settings) > 0 {
+		for _, msg := range m.Settings {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Update_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Update_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n5, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Delete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Delete) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *QueuesCmds_Delete_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Delete_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Delete_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Delete_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result {
+		dAtA[i] = 0x8
+		i++
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Pause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Pause) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *QueuesCmds_Pause_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Pause_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Pause_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Pause_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n6, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Resume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Resume) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *QueuesCmds_Resume_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Resume_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Resume_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Resume_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n7, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Purge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Purge) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *QueuesCmds_Purge_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Purge_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Delayed {
+		dAtA[i] = 0x10
+		i++
+		if m.Delayed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DeadLettered {
+		dAtA[i] = 0x18
+		i++
+		if m.DeadLettered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Purge_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Purge_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Stats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Stats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *QueuesCmds_Stats_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Stats_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *QueuesCmds_Stats_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuesCmds_Stats_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pending != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Pending))
+	}
+	if m.Delayed != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Delayed))
+	}
+	if m.Processing != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Processing))
+	}
+	if m.Finished != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Finished))
+	}
+	if m.Failed != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Failed))
+	}
+	if m.Expired != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Expired))
+	}
+	if m.DeadLettered != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.DeadLettered))
+	}
+	if m.Enqueued != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Enqueued))
+	}
+	if m.Dequeued != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Dequeued))
+	}
+	if m.EnqueueRate != 0 {
+		dAtA[i] = 0x51
+		i++
+		i = encodeFixed64Queries(dAtA, i, uint64(math.Float64bits(float64(m.EnqueueRate))))
+	}
+	if m.DequeueRate != 0 {
+		dAtA[i] = 0x59
+		i++
+		i = encodeFixed64Queries(dAtA, i, uint64(math.Float64bits(float64(m.DequeueRate))))
+	}
+	if m.OldestPendingAge != 0 {
+		dAtA[i] = 0x61
+		i++
+		i = encodeFixed64Queries(dAtA, i, uint64(math.Float64bits(float64(m.OldestPendingAge))))
+	}
+	return i, nil
+}
+
+func (m *Task) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Task) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.QueueId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QueueId)))
+		i += copy(dAtA[i:], m.QueueId)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Status))
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Input) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Input)))
+		i += copy(dAtA[i:], m.Input)
+	}
+	if len(m.CreatedAt) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CreatedAt)))
+		i += copy(dAtA[i:], m.CreatedAt)
+	}
+	if len(m.ExpiresAt) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ExpiresAt)))
+		i += copy(dAtA[i:], m.ExpiresAt)
+	}
+	if len(m.FinishedAt) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.FinishedAt)))
+		i += copy(dAtA[i:], m.FinishedAt)
+	}
+	if len(m.RunAt) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.RunAt)))
+		i += copy(dAtA[i:], m.RunAt)
+	}
+	if m.Attempts != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Attempts))
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	if len(m.JobId) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.JobId)))
+		i += copy(dAtA[i:], m.JobId)
+	}
+	if len(m.Output) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Output)))
+		i += copy(dAtA[i:], m.Output)
+	}
+	if len(m.WorkflowId) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.WorkflowId)))
+		i += copy(dAtA[i:], m.WorkflowId)
+	}
+	if len(m.BatchId) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.BatchId)))
+		i += copy(dAtA[i:], m.BatchId)
+	}
+	if len(m.UniqueKey) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.UniqueKey)))
+		i += copy(dAtA[i:], m.UniqueKey)
+	}
+	return i, nil
+}
+
+func (m *Task_Header) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Task_Header) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *TasksCmds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *TasksCmds_Publish) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_Publish) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *TasksCmds_Publish_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_Publish_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queue) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Queue)))
+		i += copy(dAtA[i:], m.Queue)
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Input) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Input)))
+		i += copy(dAtA[i:], m.Input)
+	}
+	if len(m.ExpiresAt) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ExpiresAt)))
+		i += copy(dAtA[i:], m.ExpiresAt)
+	}
+	if len(m.RunAt) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.RunAt)))
+		i += copy(dAtA[i:], m.RunAt)
+	}
+	if m.Delay != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Delay))
+	}
+	if len(m.Exchange) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Exchange)))
+		i += copy(dAtA[i:], m.Exchange)
+	}
+	if len(m.RoutingKey) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.RoutingKey)))
+		i += copy(dAtA[i:], m.RoutingKey)
+	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
+	if len(m.UniqueKey) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.UniqueKey)))
+		i += copy(dAtA[i:], m.UniqueKey)
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_Publish_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_Publish_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n8, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_Read) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_Read) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *TasksCmds_Read_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_Read_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_Read_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_Read_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n9, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_List) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_List) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *TasksCmds_List_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_List_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueueId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QueueId)))
+		i += copy(dAtA[i:], m.QueueId)
+	}
+	if m.Params != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Params.Size()))
+		n10, err := m.Params.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_List_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_List_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Info.Size()))
+		n11, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_Cancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_Cancel) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *TasksCmds_Cancel_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_Cancel_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_Cancel_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_Cancel_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result {
+		dAtA[i] = 0x8
+		i++
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_CountExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_CountExpired) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *TasksCmds_CountExpired_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_CountExpired_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueueId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QueueId)))
+		i += copy(dAtA[i:], m.QueueId)
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_CountExpired_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_CountExpired_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_GetResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_GetResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *TasksCmds_GetResult_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_GetResult_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_GetResult_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_GetResult_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Ready {
+		dAtA[i] = 0x8
+		i++
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Output) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Output)))
+		i += copy(dAtA[i:], m.Output)
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	if len(m.FinishedAt) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.FinishedAt)))
+		i += copy(dAtA[i:], m.FinishedAt)
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_AwaitResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_AwaitResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *TasksCmds_AwaitResult_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_AwaitResult_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Timeout))
+	}
+	return i, nil
+}
+
+func (m *TasksCmds_AwaitResult_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TasksCmds_AwaitResult_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Ready {
+		dAtA[i] = 0x8
+		i++
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Output) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Output)))
+		i += copy(dAtA[i:], m.Output)
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	if len(m.FinishedAt) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.FinishedAt)))
+		i += copy(dAtA[i:], m.FinishedAt)
+	}
+	return i, nil
+}
+
+func (m *Job) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.TaskId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.TaskId)))
+		i += copy(dAtA[i:], m.TaskId)
+	}
+	if len(m.QueueId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QueueId)))
+		i += copy(dAtA[i:], m.QueueId)
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Input) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Input)))
+		i += copy(dAtA[i:], m.Input)
+	}
+	if m.Progress != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Progress))
+	}
+	if len(m.Logs) > 0 {
+		for _, s := range m.Logs {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.CreatedAt) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CreatedAt)))
+		i += copy(dAtA[i:], m.CreatedAt)
+	}
+	if len(m.DeliveredAt) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.DeliveredAt)))
+		i += copy(dAtA[i:], m.DeliveredAt)
+	}
+	if len(m.FinishedAt) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.FinishedAt)))
+		i += copy(dAtA[i:], m.FinishedAt)
+	}
+	if len(m.WorkerId) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.WorkerId)))
+		i += copy(dAtA[i:], m.WorkerId)
+	}
+	return i, nil
+}
+
+func (m *JobsCmds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *JobsCmds_Consume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *JobsCmds_Consume_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Command != nil {
+		nn12, err := m.Command.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn12
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Consume_Request_Subscribe) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Subscribe != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Subscribe.Size()))
+		n13, err := m.Subscribe.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+func (m *JobsCmds_Consume_Request_Ack) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Ack != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Ack.Size()))
+		n14, err := m.Ack.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+func (m *JobsCmds_Consume_Request_Nack) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Nack != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Nack.Size()))
+		n15, err := m.Nack.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+func (m *JobsCmds_Consume_Request_Reject) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Reject != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Reject.Size()))
+		n16, err := m.Reject.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	return i, nil
+}
+func (m *JobsCmds_Consume_Request_Progress) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Progress != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Progress.Size()))
+		n17, err := m.Progress.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
+func (m *JobsCmds_Consume_Request_Log) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Log != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Log.Size()))
+		n18, err := m.Log.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
+}
+func (m *JobsCmds_Consume_Request_Touch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Touch != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Touch.Size()))
+		n19, err := m.Touch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	return i, nil
+}
+func (m *JobsCmds_Consume_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Job != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Job.Size()))
+		n20, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.Failure != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Failure.Size()))
+		n21, err := m.Failure.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Consume_Failure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume_Failure) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.JobId)))
+		i += copy(dAtA[i:], m.JobId)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Consume_Subscribe) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume_Subscribe) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, s := range m.Queues {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Prefetch != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Prefetch))
+	}
+	if len(m.WorkerId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.WorkerId)))
+		i += copy(dAtA[i:], m.WorkerId)
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Consume_Ack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume_Ack) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.JobId)))
+		i += copy(dAtA[i:], m.JobId)
+	}
+	if len(m.Output) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Output)))
+		i += copy(dAtA[i:], m.Output)
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Consume_Nack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume_Nack) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.JobId)))
+		i += copy(dAtA[i:], m.JobId)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Consume_Reject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume_Reject) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.JobId)))
+		i += copy(dAtA[i:], m.JobId)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Consume_Progress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume_Progress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.JobId)))
+		i += copy(dAtA[i:], m.JobId)
+	}
+	if m.Progress != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Progress))
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Consume_Log) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume_Log) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.JobId)))
+		i += copy(dAtA[i:], m.JobId)
+	}
+	if len(m.Lines) > 0 {
+		for _, s := range m.Lines {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Consume_Touch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Consume_Touch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.JobId)))
+		i += copy(dAtA[i:], m.JobId)
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Read) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Read) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *JobsCmds_Read_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Read_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Read_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Read_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n22, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Tail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Tail) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *JobsCmds_Tail_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Tail_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *JobsCmds_Tail_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobsCmds_Tail_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Progress != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Progress))
+	}
+	if len(m.Lines) > 0 {
+		for _, s := range m.Lines {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Finished {
+		dAtA[i] = 0x18
+		i++
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.QueueId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QueueId)))
+		i += copy(dAtA[i:], m.QueueId)
+	}
+	if len(m.Expression) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Expression)))
+		i += copy(dAtA[i:], m.Expression)
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Input) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Input)))
+		i += copy(dAtA[i:], m.Input)
+	}
+	if len(m.NextRunAt) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.NextRunAt)))
+		i += copy(dAtA[i:], m.NextRunAt)
+	}
+	if len(m.CreatedAt) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CreatedAt)))
+		i += copy(dAtA[i:], m.CreatedAt)
+	}
+	return i, nil
+}
+
+func (m *SchedulesCmds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SchedulesCmds_List) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_List) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SchedulesCmds_List_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_List_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Params.Size()))
+		n23, err := m.Params.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+
+func (m *SchedulesCmds_List_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_List_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Info.Size()))
+		n24, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SchedulesCmds_Create) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Create) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SchedulesCmds_Create_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Create_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queue) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Queue)))
+		i += copy(dAtA[i:], m.Queue)
+	}
+	if len(m.Expression) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Expression)))
+		i += copy(dAtA[i:], m.Expression)
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Input) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Input)))
+		i += copy(dAtA[i:], m.Input)
+	}
+	return i, nil
+}
+
+func (m *SchedulesCmds_Create_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Create_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n25, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	return i, nil
+}
+
+func (m *SchedulesCmds_Read) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Read) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SchedulesCmds_Read_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Read_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *SchedulesCmds_Read_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Read_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n26, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	return i, nil
+}
+
+func (m *SchedulesCmds_Update) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Update) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SchedulesCmds_Update_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Update_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Expression) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Expression)))
+		i += copy(dAtA[i:], m.Expression)
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Input) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Input)))
+		i += copy(dAtA[i:], m.Input)
+	}
+	return i, nil
+}
+
+func (m *SchedulesCmds_Update_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Update_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n27, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	return i, nil
+}
+
+func (m *SchedulesCmds_Delete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Delete) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SchedulesCmds_Delete_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Delete_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *SchedulesCmds_Delete_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulesCmds_Delete_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result {
+		dAtA[i] = 0x8
+		i++
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Worker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Queues) > 0 {
+		for _, s := range m.Queues {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Concurrency != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Concurrency))
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Status))
+	}
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.CreatedAt) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CreatedAt)))
+		i += copy(dAtA[i:], m.CreatedAt)
+	}
+	if len(m.HeartbeatAt) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.HeartbeatAt)))
+		i += copy(dAtA[i:], m.HeartbeatAt)
+	}
+	return i, nil
+}
+
+func (m *WorkersCmds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *WorkersCmds_List) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_List) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *WorkersCmds_List_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_List_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Params.Size()))
+		n28, err := m.Params.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	return i, nil
+}
+
+func (m *WorkersCmds_List_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_List_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Info.Size()))
+		n29, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *WorkersCmds_Create) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_Create) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *WorkersCmds_Create_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_Create_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Queues) > 0 {
+		for _, s := range m.Queues {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Concurrency != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Concurrency))
+	}
+	return i, nil
+}
+
+func (m *WorkersCmds_Create_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_Create_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n30, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	return i, nil
+}
+
+func (m *WorkersCmds_Read) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_Read) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *WorkersCmds_Read_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_Read_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *WorkersCmds_Read_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_Read_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n31, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	return i, nil
+}
+
+func (m *WorkersCmds_Heartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_Heartbeat) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *WorkersCmds_Heartbeat_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_Heartbeat_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *WorkersCmds_Heartbeat_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkersCmds_Heartbeat_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result {
+		dAtA[i] = 0x8
+		i++
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Exchange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Exchange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.CreatedAt) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CreatedAt)))
+		i += copy(dAtA[i:], m.CreatedAt)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Type))
+	}
+	return i, nil
+}
+
+func (m *ExchangesCmds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExchangesCmds_List) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_List) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExchangesCmds_List_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_List_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Params.Size()))
+		n32, err := m.Params.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	return i, nil
+}
+
+func (m *ExchangesCmds_List_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_List_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Info.Size()))
+		n33, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExchangesCmds_Create) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_Create) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExchangesCmds_Create_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_Create_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Type))
+	}
+	return i, nil
+}
+
+func (m *ExchangesCmds_Create_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_Create_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n34, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	return i, nil
+}
+
+func (m *ExchangesCmds_Read) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_Read) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExchangesCmds_Read_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_Read_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *ExchangesCmds_Read_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_Read_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n35, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	return i, nil
+}
+
+func (m *ExchangesCmds_Delete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_Delete) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExchangesCmds_Delete_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_Delete_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *ExchangesCmds_Delete_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangesCmds_Delete_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result {
+		dAtA[i] = 0x8
+		i++
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ExchangeBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBinding) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.ExchangeId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ExchangeId)))
+		i += copy(dAtA[i:], m.ExchangeId)
+	}
+	if len(m.QueueId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.QueueId)))
+		i += copy(dAtA[i:], m.QueueId)
+	}
+	if len(m.Rules) > 0 {
+		for _, msg := range m.Rules {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.CreatedAt) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CreatedAt)))
+		i += copy(dAtA[i:], m.CreatedAt)
+	}
+	if len(m.RoutingKey) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.RoutingKey)))
+		i += copy(dAtA[i:], m.RoutingKey)
+	}
+	return i, nil
+}
+
+func (m *ExchangeBinding_Rule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBinding_Rule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Operator != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Operator))
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_List) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_List) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_List_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_List_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.ExchangeId)))
+		i += copy(dAtA[i:], m.ExchangeId)
+	}
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_List_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_List_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_Create) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_Create) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_Create_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_Create_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exchange) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Exchange)))
+		i += copy(dAtA[i:], m.Exchange)
+	}
+	if len(m.Queue) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Queue)))
+		i += copy(dAtA[i:], m.Queue)
+	}
+	if len(m.Rules) > 0 {
+		for _, msg := range m.Rules {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RoutingKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.RoutingKey)))
+		i += copy(dAtA[i:], m.RoutingKey)
+	}
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_Create_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_Create_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n36, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_Read) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_Read) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_Read_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_Read_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_Read_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_Read_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n37, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_Delete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_Delete) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_Delete_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_Delete_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *ExchangeBindingsCmds_Delete_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeBindingsCmds_Delete_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result {
+		dAtA[i] = 0x8
+		i++
+		if m.Result {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Workflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Workflow) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Tasks) > 0 {
+		for _, msg := range m.Tasks {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.CreatedAt) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CreatedAt)))
+		i += copy(dAtA[i:], m.CreatedAt)
+	}
+	return i, nil
+}
+
+func (m *Workflow_Node) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Workflow_Node) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.TaskId)))
+		i += copy(dAtA[i:], m.TaskId)
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.ParentIds) > 0 {
+		for _, s := range m.ParentIds {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *WorkflowsCmds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *WorkflowsCmds_List) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds_List) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *WorkflowsCmds_List_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds_List_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Params.Size()))
+		n38, err := m.Params.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	return i, nil
+}
+
+func (m *WorkflowsCmds_List_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds_List_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Info.Size()))
+		n39, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *WorkflowsCmds_Create) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds_Create) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *WorkflowsCmds_Create_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds_Create_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Tasks) > 0 {
+		for _, msg := range m.Tasks {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *WorkflowsCmds_Create_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds_Create_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n40, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	return i, nil
+}
+
+func (m *WorkflowsCmds_Create_Spec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds_Create_Spec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Queue) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Queue)))
+		i += copy(dAtA[i:], m.Queue)
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Input) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Input)))
+		i += copy(dAtA[i:], m.Input)
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *WorkflowsCmds_Read) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds_Read) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *WorkflowsCmds_Read_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds_Read_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *WorkflowsCmds_Read_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowsCmds_Read_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n41, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	return i, nil
+}
+
+func (m *Batch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Total))
+	}
+	if m.Succeeded != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Failed))
+	}
+	if len(m.CallbackTaskId) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CallbackTaskId)))
+		i += copy(dAtA[i:], m.CallbackTaskId)
+	}
+	if m.IncludeResults {
+		dAtA[i] = 0x38
+		i++
+		if m.IncludeResults {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.CreatedAt) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.CreatedAt)))
+		i += copy(dAtA[i:], m.CreatedAt)
+	}
+	if len(m.FinishedAt) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.FinishedAt)))
+		i += copy(dAtA[i:], m.FinishedAt)
+	}
+	return i, nil
+}
+
+func (m *BatchesCmds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *BatchesCmds_List) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds_List) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *BatchesCmds_List_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds_List_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Params.Size()))
+		n42, err := m.Params.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	return i, nil
+}
+
+func (m *BatchesCmds_List_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds_List_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Info.Size()))
+		n43, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BatchesCmds_Create) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds_Create) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *BatchesCmds_Create_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds_Create_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Tasks) > 0 {
+		for _, msg := range m.Tasks {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Callback != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Callback.Size()))
+		n44, err := m.Callback.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	if m.IncludeResults {
+		dAtA[i] = 0x20
+		i++
+		if m.IncludeResults {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *BatchesCmds_Create_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds_Create_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n45, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	if len(m.Tasks) > 0 {
+		for _, msg := range m.Tasks {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BatchesCmds_Create_Spec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds_Create_Spec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queue) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Queue)))
+		i += copy(dAtA[i:], m.Queue)
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, msg := range m.Headers {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintQueries(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Input) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Input)))
+		i += copy(dAtA[i:], m.Input)
+	}
+	return i, nil
+}
+
+func (m *BatchesCmds_Read) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds_Read) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *BatchesCmds_Read_Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds_Read_Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	return i, nil
+}
+
+func (m *BatchesCmds_Read_Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchesCmds_Read_Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintQueries(dAtA, i, uint64(m.Record.Size()))
+		n46, err := m.Record.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
+	return i, nil
+}
+
+func encodeFixed64Queries(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Queries(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintQueries(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Queue) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Settings) > 0 {
+		for _, e := range m.Settings {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovQueries(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *Queue_Setting) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueuesCmds_List) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueuesCmds_List_Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_List_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueuesCmds_Create) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueuesCmds_Create_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Settings) > 0 {
+		for _, e := range m.Settings {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueuesCmds_Create_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Read) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueuesCmds_Read_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Read_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Update) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueuesCmds_Update_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Settings) > 0 {
+		for _, e := range m.Settings {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovQueries(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Update_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Delete) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueuesCmds_Delete_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Delete_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueuesCmds_Pause) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueuesCmds_Pause_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Pause_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Resume) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueuesCmds_Resume_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Resume_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Purge) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueuesCmds_Purge_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Delayed {
+		n += 2
+	}
+	if m.DeadLettered {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueuesCmds_Purge_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQueries(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Stats) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueuesCmds_Stats_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuesCmds_Stats_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pending != 0 {
+		n += 1 + sovQueries(uint64(m.Pending))
+	}
+	if m.Delayed != 0 {
+		n += 1 + sovQueries(uint64(m.Delayed))
+	}
+	if m.Processing != 0 {
+		n += 1 + sovQueries(uint64(m.Processing))
+	}
+	if m.Finished != 0 {
+		n += 1 + sovQueries(uint64(m.Finished))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovQueries(uint64(m.Failed))
+	}
+	if m.Expired != 0 {
+		n += 1 + sovQueries(uint64(m.Expired))
+	}
+	if m.DeadLettered != 0 {
+		n += 1 + sovQueries(uint64(m.DeadLettered))
+	}
+	if m.Enqueued != 0 {
+		n += 1 + sovQueries(uint64(m.Enqueued))
+	}
+	if m.Dequeued != 0 {
+		n += 1 + sovQueries(uint64(m.Dequeued))
+	}
+	if m.EnqueueRate != 0 {
+		n += 9
+	}
+	if m.DequeueRate != 0 {
+		n += 9
+	}
+	if m.OldestPendingAge != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *Task) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.QueueId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQueries(uint64(m.Status))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQueries(uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.FinishedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.RunAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovQueries(uint64(m.Attempts))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.BatchId)
+	if l > 0 {
+		n += 2 + l + sovQueries(uint64(l))
+	}
+	l = len(m.UniqueKey)
+	if l > 0 {
+		n += 2 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *Task_Header) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *TasksCmds) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TasksCmds_Publish) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TasksCmds_Publish_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQueries(uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.RunAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Delay != 0 {
+		n += 1 + sovQueries(uint64(m.Delay))
+	}
+	l = len(m.Exchange)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.RoutingKey)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.UniqueKey)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *TasksCmds_Publish_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TasksCmds_Read) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TasksCmds_Read_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *TasksCmds_Read_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *TasksCmds_List) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TasksCmds_List_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.QueueId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *TasksCmds_List_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TasksCmds_Cancel) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TasksCmds_Cancel_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *TasksCmds_Cancel_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	return n
+}
+
+func (m *TasksCmds_CountExpired) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TasksCmds_CountExpired_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.QueueId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *TasksCmds_CountExpired_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQueries(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *TasksCmds_GetResult) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TasksCmds_GetResult_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *TasksCmds_GetResult_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Ready {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovQueries(uint64(m.Status))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.FinishedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *TasksCmds_AwaitResult) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TasksCmds_AwaitResult_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovQueries(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *TasksCmds_AwaitResult_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Ready {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovQueries(uint64(m.Status))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.FinishedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *Job) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.QueueId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Progress != 0 {
+		n += 1 + sovQueries(uint64(m.Progress))
+	}
+	if len(m.Logs) > 0 {
+		for _, s := range m.Logs {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.DeliveredAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.FinishedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.WorkerId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *JobsCmds_Consume) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *JobsCmds_Consume_Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Command != nil {
+		n += m.Command.Size()
+	}
+	return n
+}
+
+func (m *JobsCmds_Consume_Request_Subscribe) Size() (n int) {
+	var l int
+	_ = l
+	if m.Subscribe != nil {
+		l = m.Subscribe.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+func (m *JobsCmds_Consume_Request_Ack) Size() (n int) {
+	var l int
+	_ = l
+	if m.Ack != nil {
+		l = m.Ack.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+func (m *JobsCmds_Consume_Request_Nack) Size() (n int) {
+	var l int
+	_ = l
+	if m.Nack != nil {
+		l = m.Nack.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+func (m *JobsCmds_Consume_Request_Reject) Size() (n int) {
+	var l int
+	_ = l
+	if m.Reject != nil {
+		l = m.Reject.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+func (m *JobsCmds_Consume_Request_Progress) Size() (n int) {
+	var l int
+	_ = l
+	if m.Progress != nil {
+		l = m.Progress.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+func (m *JobsCmds_Consume_Request_Log) Size() (n int) {
+	var l int
+	_ = l
+	if m.Log != nil {
+		l = m.Log.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+func (m *JobsCmds_Consume_Request_Touch) Size() (n int) {
+	var l int
+	_ = l
+	if m.Touch != nil {
+		l = m.Touch.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+func (m *JobsCmds_Consume_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds_Consume_Failure) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds_Consume_Subscribe) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, s := range m.Queues {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if m.Prefetch != 0 {
+		n += 1 + sovQueries(uint64(m.Prefetch))
+	}
+	l = len(m.WorkerId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds_Consume_Ack) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds_Consume_Nack) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds_Consume_Reject) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds_Consume_Progress) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Progress != 0 {
+		n += 1 + sovQueries(uint64(m.Progress))
+	}
+	return n
+}
+
+func (m *JobsCmds_Consume_Log) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Lines) > 0 {
+		for _, s := range m.Lines {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobsCmds_Consume_Touch) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds_Read) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *JobsCmds_Read_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds_Read_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds_Tail) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *JobsCmds_Tail_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *JobsCmds_Tail_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Progress != 0 {
+		n += 1 + sovQueries(uint64(m.Progress))
+	}
+	if len(m.Lines) > 0 {
+		for _, s := range m.Lines {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if m.Finished {
+		n += 2
+	}
+	return n
+}
+
+func (m *Schedule) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.QueueId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQueries(uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.NextRunAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulesCmds) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SchedulesCmds_List) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SchedulesCmds_List_Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulesCmds_List_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SchedulesCmds_Create) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SchedulesCmds_Create_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQueries(uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulesCmds_Create_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulesCmds_Read) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SchedulesCmds_Read_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulesCmds_Read_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulesCmds_Update) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SchedulesCmds_Update_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQueries(uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulesCmds_Update_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulesCmds_Delete) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SchedulesCmds_Delete_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulesCmds_Delete_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	return n
+}
+
+func (m *Worker) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Queues) > 0 {
+		for _, s := range m.Queues {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if m.Concurrency != 0 {
+		n += 1 + sovQueries(uint64(m.Concurrency))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQueries(uint64(m.Status))
+	}
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.HeartbeatAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkersCmds) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WorkersCmds_List) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WorkersCmds_List_Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkersCmds_List_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkersCmds_Create) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WorkersCmds_Create_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Queues) > 0 {
+		for _, s := range m.Queues {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if m.Concurrency != 0 {
+		n += 1 + sovQueries(uint64(m.Concurrency))
+	}
+	return n
+}
+
+func (m *WorkersCmds_Create_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkersCmds_Read) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WorkersCmds_Read_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkersCmds_Read_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkersCmds_Heartbeat) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WorkersCmds_Heartbeat_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkersCmds_Heartbeat_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	return n
+}
+
+func (m *Exchange) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQueries(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *ExchangesCmds) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangesCmds_List) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangesCmds_List_Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangesCmds_List_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExchangesCmds_Create) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangesCmds_Create_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQueries(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *ExchangesCmds_Create_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangesCmds_Read) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangesCmds_Read_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangesCmds_Read_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangesCmds_Delete) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangesCmds_Delete_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangesCmds_Delete_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExchangeBinding) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.ExchangeId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.QueueId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.RoutingKey)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeBinding_Rule) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovQueries(uint64(m.Operator))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeBindingsCmds) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangeBindingsCmds_List) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangeBindingsCmds_List_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ExchangeId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeBindingsCmds_List_Response) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExchangeBindingsCmds_Create) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangeBindingsCmds_Create_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Exchange)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.RoutingKey)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeBindingsCmds_Create_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeBindingsCmds_Read) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangeBindingsCmds_Read_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeBindingsCmds_Read_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeBindingsCmds_Delete) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExchangeBindingsCmds_Delete_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeBindingsCmds_Delete_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Result {
+		n += 2
+	}
+	return n
+}
+
+func (m *Workflow) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQueries(uint64(m.Status))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *Workflow_Node) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.ParentIds) > 0 {
+		for _, s := range m.ParentIds {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowsCmds) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WorkflowsCmds_List) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WorkflowsCmds_List_Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowsCmds_List_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowsCmds_Create) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WorkflowsCmds_Create_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowsCmds_Create_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowsCmds_Create_Spec) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQueries(uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WorkflowsCmds_Read) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *WorkflowsCmds_Read_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowsCmds_Read_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *Batch) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovQueries(uint64(m.Total))
+	}
+	if m.Succeeded != 0 {
+		n += 1 + sovQueries(uint64(m.Succeeded))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovQueries(uint64(m.Failed))
+	}
+	l = len(m.CallbackTaskId)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.IncludeResults {
+		n += 2
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	l = len(m.FinishedAt)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchesCmds) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchesCmds_List) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchesCmds_List_Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchesCmds_List_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchesCmds_Create) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchesCmds_Create_Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	if m.Callback != nil {
+		l = m.Callback.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.IncludeResults {
+		n += 2
+	}
+	return n
+}
+
+func (m *BatchesCmds_Create_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchesCmds_Create_Spec) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovQueries(uint64(m.Priority))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQueries(uint64(l))
+		}
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchesCmds_Read) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchesCmds_Read_Request) Size() (n int) {
+	var l int
+	_ = l
 	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovQueries(uint64(l))
 	}
-	return n
+	return n
+}
+
+func (m *BatchesCmds_Read_Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQueries(uint64(l))
+	}
+	return n
+}
+
+func sovQueries(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozQueries(x uint64) (n int) {
+	return sovQueries(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Queue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Queue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Queue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settings = append(m.Settings, &Queue_Setting{})
+			if err := m.Settings[len(m.Settings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Queue_Setting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Setting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Setting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuesCmds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuesCmds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_List_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Collection_Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_List_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &Collection_Info{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &Queue{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Create) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Create: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Create: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Create_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settings = append(m.Settings, &Queue_Setting{})
+			if err := m.Settings[len(m.Settings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Create_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Queue{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Read) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Read: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Read: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Read_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Read_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Queue{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Update) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Update: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Update_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settings = append(m.Settings, &Queue_Setting{})
+			if err := m.Settings[len(m.Settings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Update_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Queue{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Delete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Delete_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Delete_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Pause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Pause_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Pause_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Queue{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Resume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Resume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Resume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Resume_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Resume_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Queue{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Purge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Purge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Purge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Purge_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delayed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLettered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadLettered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Purge_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Stats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Stats_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuesCmds_Stats_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
+			}
+			m.Delayed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delayed |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processing", wireType)
+			}
+			m.Processing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Processing |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			m.Finished = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finished |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			m.Expired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expired |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLettered", wireType)
+			}
+			m.DeadLettered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadLettered |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enqueued", wireType)
+			}
+			m.Enqueued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Enqueued |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dequeued", wireType)
+			}
+			m.Dequeued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dequeued |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.EnqueueRate = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DequeueRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.DequeueRate = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestPendingAge", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.OldestPendingAge = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Task) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Task_Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (uint8(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Task_Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinishedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = append(m.Output[:0], dAtA[iNdEx:postIndex]...)
+			if m.Output == nil {
+				m.Output = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UniqueKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Task_Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TasksCmds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TasksCmds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_Publish) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Publish: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Publish: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_Publish_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (uint8(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Task_Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			m.Delay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delay |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exchange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exchange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutingKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UniqueKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_Publish_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Task{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &Task{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_Read) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Read: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Read: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_Read_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_Read_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Task{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_List_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Collection_Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_List_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &Collection_Info{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &Task{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_Cancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_Cancel_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_Cancel_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_CountExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CountExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CountExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_CountExpired_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_CountExpired_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_GetResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_GetResult_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_GetResult_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Task_Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = append(m.Output[:0], dAtA[iNdEx:postIndex]...)
+			if m.Output == nil {
+				m.Output = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinishedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_AwaitResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AwaitResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AwaitResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_AwaitResult_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TasksCmds_AwaitResult_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Task_Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = append(m.Output[:0], dAtA[iNdEx:postIndex]...)
+			if m.Output == nil {
+				m.Output = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinishedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Job) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Task_Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			m.Progress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Progress |= (uint8(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveredAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinishedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobsCmds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobsCmds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Consume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Consume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobsCmds_Consume_Subscribe{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &JobsCmds_Consume_Request_Subscribe{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobsCmds_Consume_Ack{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &JobsCmds_Consume_Request_Ack{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobsCmds_Consume_Nack{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &JobsCmds_Consume_Request_Nack{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobsCmds_Consume_Reject{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &JobsCmds_Consume_Request_Reject{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobsCmds_Consume_Progress{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &JobsCmds_Consume_Request_Progress{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobsCmds_Consume_Log{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &JobsCmds_Consume_Request_Log{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Touch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobsCmds_Consume_Touch{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Command = &JobsCmds_Consume_Request_Touch{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &JobsCmds_Consume_Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume_Failure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Failure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Failure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume_Subscribe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscribe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscribe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefetch", wireType)
+			}
+			m.Prefetch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Prefetch |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume_Ack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = append(m.Output[:0], dAtA[iNdEx:postIndex]...)
+			if m.Output == nil {
+				m.Output = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume_Nack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Nack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Nack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume_Reject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume_Progress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Progress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Progress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			m.Progress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Progress |= (uint8(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume_Log) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Log: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lines = append(m.Lines, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Consume_Touch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Touch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Touch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Read) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Read: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Read: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Read_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Read_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Job{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Tail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Tail_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobsCmds_Tail_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			m.Progress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Progress |= (uint8(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lines = append(m.Lines, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finished = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (uint8(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Task_Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextRunAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulesCmds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulesCmds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_List_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Collection_Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_List_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &Collection_Info{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &Schedule{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Create) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Create: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Create: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Create_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (uint8(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Task_Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Create_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Schedule{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Read) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Read: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Read: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Read_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Read_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Schedule{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Update) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Update: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Update_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (uint8(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Task_Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Update_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Schedule{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Delete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Delete_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulesCmds_Delete_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Worker_Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeartbeatAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkersCmds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkersCmds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_List_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Collection_Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_List_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &Collection_Info{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &Worker{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_Create) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Create: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Create: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_Create_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			m.Concurrency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Concurrency |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_Create_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Worker{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_Read) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Read: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Read: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_Read_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_Read_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Worker{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_Heartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Heartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Heartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_Heartbeat_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkersCmds_Heartbeat_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Exchange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Exchange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Exchange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (Exchange_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangesCmds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangesCmds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_List_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Collection_Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_List_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &Collection_Info{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &Exchange{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_Create) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Create: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Create: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_Create_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (Exchange_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_Create_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Exchange{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_Read) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Read: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Read: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_Read_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_Read_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Exchange{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_Delete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_Delete_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangesCmds_Delete_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, &ExchangeBinding_Rule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutingKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBinding_Rule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= (ExchangeBinding_Rule_Operator(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeBindingsCmds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeBindingsCmds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_List_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_List_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &ExchangeBinding{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_Create) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Create: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Create: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_Create_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exchange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exchange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, &ExchangeBinding_Rule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoutingKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_Create_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &ExchangeBinding{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_Read) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Read: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Read: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_Read_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_Read_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &ExchangeBinding{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_Delete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_Delete_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeBindingsCmds_Delete_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Result = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Workflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Workflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Workflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (Workflow_Status(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &Workflow_Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Workflow_Node) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentIds = append(m.ParentIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowsCmds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowsCmds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowsCmds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowsCmds_List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowsCmds_List_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Collection_Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowsCmds_List_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &Collection_Info{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &Workflow{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowsCmds_Create) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Create: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Create: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowsCmds_Create_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &WorkflowsCmds_Create_Spec{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowsCmds_Create_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Workflow{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowsCmds_Create_Spec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Spec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Spec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (uint8(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &Task_Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *WorkflowsCmds_Read) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Read: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Read: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueuesCmds_Read_Response) Size() (n int) {
-	var l int
-	_ = l
-	if m.Record != nil {
-		l = m.Record.Size()
-		n += 1 + l + sovQueries(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowsCmds_Read_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
-
-func (m *QueuesCmds_Delete) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueuesCmds_Delete_Request) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQueries(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueuesCmds_Delete_Response) Size() (n int) {
-	var l int
-	_ = l
-	if m.Result {
-		n += 2
+func (m *WorkflowsCmds_Read_Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueries
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Workflow{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueries(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueries
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQueries(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozQueries(x uint64) (n int) {
-	return sovQueries(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Queue) Unmarshal(dAtA []byte) error {
+func (m *Batch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -988,10 +25388,10 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Queue: wiretype end group for non-group")
+			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Queue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1053,10 +25453,10 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var msglen int
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueries
@@ -1066,26 +25466,52 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Total |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQueries
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Settings = append(m.Settings, &Queue_Setting{})
-			if err := m.Settings[len(m.Settings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			iNdEx = postIndex
-		case 4:
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackTaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1110,61 +25536,31 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.CallbackTaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueries(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthQueries
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Queue_Setting) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueries
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeResults", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Setting: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Setting: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IncludeResults = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1189,11 +25585,11 @@ func (m *Queue_Setting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1218,7 +25614,7 @@ func (m *Queue_Setting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.FinishedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1241,7 +25637,7 @@ func (m *Queue_Setting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1264,10 +25660,10 @@ func (m *QueuesCmds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueuesCmds: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchesCmds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuesCmds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchesCmds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1291,7 +25687,7 @@ func (m *QueuesCmds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds_List) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds_List) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,7 +25737,7 @@ func (m *QueuesCmds_List) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds_List_Request) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds_List_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1424,7 +25820,7 @@ func (m *QueuesCmds_List_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds_List_Response) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds_List_Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1512,7 +25908,7 @@ func (m *QueuesCmds_List_Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &Queue{})
+			m.Records = append(m.Records, &Batch{})
 			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1538,7 +25934,7 @@ func (m *QueuesCmds_List_Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds_Create) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds_Create) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1588,7 +25984,7 @@ func (m *QueuesCmds_Create) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds_Create_Request) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds_Create_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1648,7 +26044,7 @@ func (m *QueuesCmds_Create_Request) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1672,11 +26068,64 @@ func (m *QueuesCmds_Create_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Settings = append(m.Settings, &Queue_Setting{})
-			if err := m.Settings[len(m.Settings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, &BatchesCmds_Create_Spec{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Callback == nil {
+				m.Callback = &BatchesCmds_Create_Spec{}
+			}
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeResults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeResults = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueries(dAtA[iNdEx:])
@@ -1698,7 +26147,7 @@ func (m *QueuesCmds_Create_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds_Create_Response) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds_Create_Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1754,62 +26203,43 @@ func (m *QueuesCmds_Create_Response) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Record == nil {
-				m.Record = &Queue{}
+				m.Record = &Batch{}
 			}
 			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueries(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthQueries
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueuesCmds_Read) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueries
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Tasks = append(m.Tasks, &Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Read: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Read: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueries(dAtA[iNdEx:])
@@ -1831,7 +26261,7 @@ func (m *QueuesCmds_Read) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds_Read_Request) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds_Create_Spec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1854,15 +26284,15 @@ func (m *QueuesCmds_Read_Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: Spec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Spec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1887,61 +26317,30 @@ func (m *QueuesCmds_Read_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueries(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthQueries
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueuesCmds_Read_Response) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueries
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (uint8(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1965,13 +26364,42 @@ func (m *QueuesCmds_Read_Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &Queue{}
-			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, &Task_Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueries
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = append(m.Input[:0], dAtA[iNdEx:postIndex]...)
+			if m.Input == nil {
+				m.Input = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueries(dAtA[iNdEx:])
@@ -1993,7 +26421,7 @@ func (m *QueuesCmds_Read_Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds_Delete) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds_Read) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2016,10 +26444,10 @@ func (m *QueuesCmds_Delete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delete: wiretype end group for non-group")
+			return fmt.Errorf("proto: Read: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Read: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2043,7 +26471,7 @@ func (m *QueuesCmds_Delete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds_Delete_Request) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds_Read_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2122,7 +26550,7 @@ func (m *QueuesCmds_Delete_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuesCmds_Delete_Response) Unmarshal(dAtA []byte) error {
+func (m *BatchesCmds_Read_Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2152,10 +26580,10 @@ func (m *QueuesCmds_Delete_Response) Unmarshal(dAtA []byte) error {
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueries
@@ -2165,12 +26593,25 @@ func (m *QueuesCmds_Delete_Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Result = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQueries
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Batch{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueries(dAtA[iNdEx:])
@@ -2300,36 +26741,231 @@ var (
 func init() { proto1.RegisterFile("queries.proto", fileDescriptorQueries) }
 
 var fileDescriptorQueries = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xc7, 0xbb, 0x8e, 0xeb, 0xa4, 0xd3, 0xef, 0x43, 0x68, 0x85, 0x90, 0xbb, 0x02, 0xab, 0x04,
-	0x21, 0x55, 0x02, 0x2c, 0x35, 0x3d, 0x70, 0xca, 0x01, 0x82, 0x04, 0x48, 0x1c, 0x60, 0xb9, 0x22,
-	0x85, 0xc5, 0x9e, 0x5a, 0x56, 0x6d, 0x6f, 0xea, 0x5d, 0x03, 0x7d, 0x13, 0x8e, 0x5c, 0xb8, 0xf1,
-	0x00, 0x1c, 0x38, 0x70, 0xac, 0x38, 0xf1, 0x08, 0x60, 0x5e, 0x04, 0x65, 0x77, 0x93, 0x54, 0x40,
-	0x9a, 0x20, 0x6e, 0xbb, 0xeb, 0xdf, 0xcc, 0x7f, 0xfe, 0x33, 0x23, 0xc3, 0xff, 0xc7, 0x0d, 0xd6,
-	0x39, 0xaa, 0x78, 0x52, 0x4b, 0x2d, 0x29, 0xcd, 0x64, 0x7d, 0x34, 0xce, 0x84, 0xc6, 0xd7, 0xe2,
-	0x44, 0x8d, 0xb3, 0x7a, 0x92, 0xb0, 0xff, 0x12, 0x59, 0x96, 0xb2, 0xb2, 0x44, 0xff, 0x13, 0x81,
-	0xcd, 0xa7, 0x0d, 0x36, 0x48, 0x2f, 0x80, 0x97, 0xa7, 0x21, 0xd9, 0x25, 0x7b, 0x5b, 0xdc, 0xcb,
-	0x53, 0x4a, 0xc1, 0xaf, 0x44, 0x89, 0xa1, 0x67, 0x5e, 0xcc, 0x99, 0x0e, 0xa1, 0xa7, 0x50, 0xeb,
-	0xbc, 0xca, 0x54, 0xd8, 0xd9, 0xed, 0xec, 0x6d, 0x0f, 0xae, 0xc5, 0xbf, 0x4b, 0xc4, 0x26, 0x61,
-	0xfc, 0xcc, 0x92, 0x7c, 0x1e, 0x42, 0xaf, 0x02, 0x24, 0x35, 0x0a, 0x8d, 0xe9, 0x58, 0xe8, 0xd0,
-	0x37, 0x89, 0xb7, 0xdc, 0xcb, 0x5d, 0xcd, 0xf6, 0xa1, 0xeb, 0x62, 0xe8, 0x45, 0xe8, 0x1c, 0xe1,
-	0x89, 0xab, 0x66, 0x7a, 0xa4, 0x97, 0x60, 0xf3, 0x95, 0x28, 0x9a, 0x59, 0x3d, 0xf6, 0xd2, 0xff,
-	0xe0, 0x03, 0x18, 0x35, 0x35, 0x2a, 0x53, 0xc5, 0xbe, 0x10, 0xf0, 0x1f, 0xe7, 0x4a, 0xb3, 0x87,
-	0xd0, 0xe5, 0x78, 0xdc, 0xa0, 0xd2, 0x74, 0x08, 0xc1, 0x44, 0xd4, 0xa2, 0x54, 0x26, 0xdb, 0xf6,
-	0xe0, 0xc6, 0x9f, 0x2a, 0x1e, 0xc9, 0xa2, 0xc0, 0x44, 0xe7, 0xb2, 0x8a, 0x9f, 0x18, 0x98, 0xbb,
-	0x20, 0xf6, 0x06, 0x7a, 0x1c, 0xd5, 0x44, 0x56, 0x0a, 0xe9, 0x1d, 0xf0, 0xf3, 0xea, 0x50, 0xba,
-	0x44, 0xd7, 0x57, 0x24, 0x7a, 0x54, 0x1d, 0x4a, 0x6e, 0x02, 0xe8, 0x01, 0x74, 0x6b, 0x4c, 0x64,
-	0x9d, 0xaa, 0xd0, 0x33, 0x6d, 0xdb, 0x59, 0xda, 0x36, 0x3e, 0x23, 0xd9, 0x7b, 0x02, 0xc1, 0xc8,
-	0x34, 0x87, 0x3d, 0x5f, 0xd8, 0x99, 0x8d, 0x85, 0x2c, 0x19, 0x8b, 0xf7, 0xd7, 0x63, 0x61, 0xc3,
-	0x33, 0x16, 0xf7, 0x21, 0xb0, 0xfa, 0xce, 0xe4, 0x39, 0x85, 0x3a, 0x90, 0xbd, 0x00, 0x9f, 0xa3,
-	0x48, 0xd9, 0xce, 0xa2, 0xc8, 0x5f, 0x76, 0xe9, 0x5f, 0x15, 0x1e, 0x40, 0x70, 0x1f, 0x0b, 0xd4,
-	0x78, 0x9e, 0x46, 0xff, 0x8c, 0xc6, 0xe5, 0xa9, 0x86, 0x6a, 0x0a, 0x6d, 0xbe, 0xf7, 0xb8, 0xbb,
-	0x0d, 0xde, 0x75, 0x20, 0xb0, 0xeb, 0x42, 0x85, 0xdd, 0x14, 0x7a, 0x73, 0xa9, 0xbc, 0x59, 0xa9,
-	0x78, 0x0a, 0xc5, 0x4e, 0x93, 0xdd, 0x5a, 0x0f, 0x76, 0x55, 0x64, 0xb3, 0xf9, 0xd1, 0xdb, 0x2b,
-	0xe2, 0x2c, 0x36, 0x97, 0x89, 0xd7, 0xc5, 0x9d, 0x90, 0xb0, 0x13, 0x58, 0xe9, 0x65, 0x0a, 0xad,
-	0xed, 0xc5, 0xc1, 0x0b, 0x2f, 0x76, 0x04, 0x2b, 0xbd, 0x58, 0x6c, 0x6d, 0x2f, 0x73, 0xdc, 0x0a,
-	0xdd, 0xbb, 0x72, 0xfa, 0x3d, 0xda, 0xf8, 0xd8, 0x46, 0xe4, 0x73, 0x1b, 0x91, 0xd3, 0x36, 0x22,
-	0x5f, 0xdb, 0x88, 0x7c, 0x6b, 0x23, 0xf2, 0xf6, 0x47, 0xb4, 0xf1, 0x32, 0x30, 0x7f, 0xad, 0x83,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb0, 0xad, 0xd2, 0xea, 0xe8, 0x04, 0x00, 0x00,
+	// 3602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x5b, 0x6c, 0x1b, 0xd7,
+	0x95, 0x1a, 0x0e, 0x87, 0x8f, 0x43, 0x49, 0xe1, 0xde, 0xf5, 0xc6, 0xcc, 0x6c, 0x2c, 0x2b, 0xcc,
+	0x06, 0xab, 0xf8, 0x41, 0x5b, 0x72, 0x62, 0x27, 0x48, 0x9c, 0x5d, 0x5a, 0xa2, 0x25, 0x26, 0x5a,
+	0x59, 0x19, 0xc9, 0x89, 0x17, 0xc8, 0x2e, 0x77, 0x34, 0x73, 0x4d, 0xd1, 0xa2, 0x66, 0xe8, 0x79,
+	0xc4, 0x16, 0x82, 0x7c, 0x6d, 0xd1, 0x02, 0x45, 0xfb, 0xd7, 0x02, 0xfd, 0xe8, 0x47, 0x11, 0xb4,
+	0x08, 0xd0, 0xaf, 0xa6, 0xfd, 0x68, 0x81, 0x34, 0x45, 0x7f, 0x5a, 0x04, 0x7d, 0x00, 0x69, 0x81,
+	0x02, 0x45, 0x50, 0x14, 0xad, 0xd3, 0x16, 0xfd, 0x2a, 0xfa, 0x15, 0x20, 0x01, 0x5a, 0x14, 0xf7,
+	0x31, 0x4f, 0x71, 0x86, 0x43, 0x4b, 0x46, 0x5a, 0xff, 0xf1, 0xde, 0x39, 0x8f, 0x7b, 0xde, 0xe7,
+	0x3e, 0x40, 0x98, 0xba, 0xe9, 0x62, 0xab, 0x87, 0xed, 0xc6, 0xc0, 0x32, 0x1d, 0x13, 0xa1, 0xae,
+	0x69, 0xed, 0x74, 0xba, 0xaa, 0x83, 0x6f, 0xa9, 0x7b, 0x76, 0xa7, 0x6b, 0x0d, 0x34, 0x79, 0x52,
+	0x33, 0x77, 0x77, 0x4d, 0x83, 0x41, 0xd4, 0xff, 0x24, 0x80, 0xf4, 0xa2, 0x8b, 0x5d, 0x8c, 0xa6,
+	0x21, 0xd7, 0xd3, 0x6b, 0xc2, 0xac, 0x30, 0x57, 0x56, 0x72, 0x3d, 0x1d, 0x21, 0xc8, 0x1b, 0xea,
+	0x2e, 0xae, 0xe5, 0xe8, 0x0c, 0xfd, 0x8d, 0x2e, 0x42, 0xc9, 0xc6, 0x8e, 0xd3, 0x33, 0xba, 0x76,
+	0x4d, 0x9c, 0x15, 0xe7, 0x2a, 0x0b, 0x8f, 0x34, 0xf6, 0xb3, 0x68, 0x50, 0x82, 0x8d, 0x0d, 0x06,
+	0xa9, 0xf8, 0x28, 0xe8, 0x18, 0x80, 0x66, 0x61, 0xd5, 0xc1, 0x7a, 0x47, 0x75, 0x6a, 0x79, 0x4a,
+	0xb8, 0xcc, 0x67, 0x9a, 0x0e, 0x7a, 0x10, 0x0a, 0x03, 0xd5, 0xb5, 0xb1, 0x5e, 0x93, 0x66, 0x85,
+	0xb9, 0x92, 0xc2, 0x47, 0xa8, 0x06, 0xc5, 0x57, 0xb1, 0x65, 0xf7, 0x4c, 0xa3, 0x56, 0x98, 0x15,
+	0xe6, 0x44, 0xc5, 0x1b, 0xca, 0xf3, 0x50, 0xe4, 0x5c, 0x50, 0x15, 0xc4, 0x1d, 0xbc, 0xc7, 0xd7,
+	0x4f, 0x7e, 0xa2, 0x23, 0x20, 0xbd, 0xaa, 0xf6, 0x5d, 0x4f, 0x02, 0x36, 0xa8, 0xff, 0xaa, 0x02,
+	0x40, 0xd7, 0x67, 0x2f, 0xee, 0xea, 0xb6, 0xfc, 0x23, 0x01, 0xf2, 0xab, 0x3d, 0xdb, 0x91, 0x57,
+	0xa0, 0xa8, 0xe0, 0x9b, 0x2e, 0xb6, 0x1d, 0x74, 0x91, 0xac, 0xc3, 0x52, 0x77, 0x6d, 0x4a, 0xad,
+	0xb2, 0xf0, 0xd8, 0x30, 0x19, 0x17, 0xcd, 0x7e, 0x1f, 0x6b, 0x4e, 0xcf, 0x34, 0x1a, 0xeb, 0x14,
+	0x58, 0xe1, 0x48, 0xf2, 0x6d, 0x28, 0x29, 0xd8, 0x1e, 0x98, 0x86, 0x8d, 0xd1, 0x05, 0xc8, 0xf7,
+	0x8c, 0xeb, 0x26, 0x27, 0xf4, 0xe8, 0x08, 0x42, 0x6d, 0xe3, 0xba, 0xa9, 0x50, 0x04, 0x74, 0x0e,
+	0x8a, 0x16, 0xd6, 0x4c, 0x4b, 0xb7, 0x6b, 0x39, 0xaa, 0xe8, 0x87, 0x12, 0x15, 0xad, 0x78, 0x90,
+	0xf2, 0xd7, 0x04, 0x28, 0x2c, 0x52, 0x75, 0xca, 0xaf, 0x04, 0xe2, 0x78, 0x86, 0x14, 0x12, 0x0c,
+	0x99, 0x1b, 0xdb, 0x90, 0xf2, 0xc5, 0x90, 0x88, 0xf3, 0x50, 0x60, 0xfc, 0xb9, 0x90, 0x29, 0x0b,
+	0xe5, 0x80, 0xf2, 0xff, 0x41, 0x5e, 0xc1, 0xaa, 0x2e, 0x3f, 0x14, 0x2c, 0x32, 0xe6, 0x7d, 0x07,
+	0xe5, 0xf0, 0xb6, 0x00, 0x85, 0xab, 0x03, 0x9d, 0x68, 0xc2, 0x4a, 0x64, 0x72, 0x40, 0x2d, 0x84,
+	0xfd, 0x52, 0x8c, 0xfa, 0xe5, 0x01, 0x57, 0xbf, 0x0c, 0x85, 0x25, 0xdc, 0xc7, 0x0e, 0x4e, 0xd3,
+	0x50, 0x3d, 0xc4, 0xe3, 0x41, 0xc2, 0xc3, 0x76, 0xfb, 0x0e, 0xfd, 0x5e, 0x52, 0xf8, 0x48, 0x56,
+	0x41, 0x5a, 0x27, 0x31, 0x74, 0x0f, 0x35, 0xbd, 0x05, 0x05, 0x05, 0xdb, 0xee, 0xee, 0xbd, 0xe4,
+	0xf1, 0xff, 0x02, 0x48, 0xeb, 0xae, 0xd5, 0xc5, 0xf2, 0xb5, 0x64, 0x63, 0xd6, 0xa0, 0xa8, 0xe3,
+	0xbe, 0xba, 0x87, 0x75, 0x1a, 0xf0, 0x25, 0xc5, 0x1b, 0xa2, 0x47, 0x61, 0x4a, 0xc7, 0xaa, 0xde,
+	0xe9, 0x63, 0xc7, 0xc1, 0x16, 0xd6, 0xa9, 0xb5, 0x4a, 0xca, 0x24, 0x99, 0x5c, 0xe5, 0x73, 0xf2,
+	0x6c, 0x68, 0x89, 0x47, 0x40, 0xd2, 0x4c, 0xd7, 0x60, 0xda, 0xcc, 0x2b, 0x6c, 0x20, 0xbf, 0x21,
+	0x82, 0xb4, 0xe1, 0xa8, 0x8e, 0x9d, 0x26, 0xe9, 0x5f, 0x72, 0x21, 0x3a, 0x35, 0x28, 0x0e, 0xb0,
+	0xa1, 0xf7, 0x8c, 0x2e, 0xa7, 0xe4, 0x0d, 0xe3, 0x8b, 0xcd, 0x07, 0x8b, 0x9d, 0x01, 0x18, 0x58,
+	0xa6, 0x86, 0x6d, 0x9b, 0xa0, 0x89, 0xf4, 0x63, 0x68, 0x06, 0xc9, 0x50, 0xba, 0xde, 0x33, 0x7a,
+	0xf6, 0x36, 0xd6, 0x69, 0x06, 0xcd, 0x2b, 0xfe, 0x98, 0xb8, 0xc1, 0x75, 0xb5, 0xd7, 0xe7, 0x09,
+	0x34, 0xaf, 0xf0, 0x11, 0xe1, 0x86, 0x6f, 0x0f, 0x7a, 0x44, 0xf4, 0x02, 0xe3, 0xc6, 0x87, 0xfb,
+	0x55, 0x53, 0xa4, 0xdf, 0x23, 0xaa, 0x21, 0x2c, 0xb1, 0x71, 0x93, 0x58, 0x44, 0xaf, 0x95, 0x18,
+	0x4b, 0x6f, 0x4c, 0xbe, 0xe9, 0x98, 0x7f, 0x2b, 0xb3, 0x6f, 0xde, 0x18, 0x3d, 0x02, 0x93, 0x1c,
+	0xae, 0x63, 0xa9, 0x0e, 0xae, 0xc1, 0xac, 0x30, 0x27, 0x28, 0x15, 0x3e, 0xa7, 0xa8, 0x0e, 0x26,
+	0x20, 0x3a, 0x0e, 0x81, 0x54, 0x18, 0x88, 0x8e, 0x03, 0x90, 0x53, 0x80, 0xcc, 0xbe, 0x8e, 0x6d,
+	0xa7, 0xc3, 0x95, 0xd7, 0x51, 0xbb, 0xb8, 0x36, 0x49, 0x01, 0xab, 0xec, 0xcb, 0x3a, 0xfb, 0xd0,
+	0xec, 0xe2, 0xfa, 0x0f, 0x25, 0xc8, 0x6f, 0xaa, 0xf6, 0xce, 0x3e, 0xf7, 0x78, 0x08, 0x4a, 0x8c,
+	0x4f, 0x4f, 0xe7, 0x05, 0xa1, 0x48, 0xc7, 0x6d, 0x1d, 0x5d, 0x80, 0x82, 0xed, 0xa8, 0x8e, 0x6b,
+	0x53, 0x75, 0x4f, 0x2f, 0x1c, 0x1f, 0xe6, 0x91, 0x84, 0x68, 0x63, 0x83, 0x82, 0x29, 0x1c, 0x1c,
+	0x3d, 0x06, 0xa5, 0x81, 0xd5, 0x33, 0xad, 0x9e, 0xb3, 0x47, 0x6d, 0x31, 0x75, 0xa9, 0xfc, 0xf1,
+	0xaf, 0x8f, 0x4b, 0x6e, 0xcf, 0x70, 0x9e, 0x52, 0xfc, 0x4f, 0xe8, 0x69, 0x28, 0x6e, 0x63, 0x55,
+	0xc7, 0x96, 0x5d, 0x93, 0x68, 0x96, 0x49, 0x66, 0xb0, 0x42, 0xe1, 0x14, 0x0f, 0x9e, 0x78, 0x62,
+	0xcf, 0x18, 0xb8, 0x0e, 0xb5, 0xdb, 0xa4, 0xc2, 0x06, 0xb1, 0x3a, 0x5a, 0x8c, 0xd7, 0xd1, 0x63,
+	0x00, 0xcc, 0xbe, 0x36, 0xf9, 0x5c, 0x62, 0x9f, 0xf9, 0x4c, 0xd3, 0x41, 0xc7, 0xa1, 0xe2, 0x79,
+	0x0c, 0xf9, 0x5e, 0xa6, 0xdf, 0xc1, 0x9b, 0x6a, 0x3a, 0xe8, 0x5f, 0xa0, 0x60, 0xb9, 0x06, 0xf9,
+	0x06, 0xac, 0x72, 0x5a, 0xae, 0xd1, 0x74, 0x88, 0xa9, 0x55, 0xc7, 0xc1, 0xbb, 0x03, 0xc7, 0xa6,
+	0x76, 0x9a, 0x52, 0xfc, 0x31, 0x61, 0xd9, 0x57, 0x6d, 0xa7, 0x83, 0x2d, 0xcb, 0xb4, 0xa8, 0x71,
+	0xca, 0x4a, 0x99, 0xcc, 0xb4, 0xc8, 0x04, 0xa1, 0x78, 0xc3, 0xdc, 0x22, 0xaa, 0x9f, 0x62, 0x14,
+	0x6f, 0x98, 0x5b, 0x6d, 0xea, 0xaf, 0xa6, 0xeb, 0x10, 0xf1, 0xa6, 0xa9, 0x78, 0x7c, 0x44, 0x56,
+	0x78, 0xcb, 0xb4, 0x76, 0xae, 0xf7, 0xcd, 0x5b, 0x04, 0xe7, 0x01, 0xb6, 0x42, 0x6f, 0xaa, 0x4d,
+	0x8d, 0xb9, 0xa5, 0x3a, 0xda, 0x36, 0xf9, 0x5a, 0x65, 0xc6, 0xa4, 0xe3, 0xb6, 0x4e, 0x56, 0xe2,
+	0x1a, 0xbd, 0x9b, 0x2e, 0xee, 0x90, 0x76, 0xe0, 0x9f, 0xd8, 0x4a, 0xd8, 0xcc, 0x0b, 0x78, 0x4f,
+	0x3e, 0x0b, 0x05, 0xa6, 0xe3, 0xcc, 0x0d, 0x83, 0x0b, 0x05, 0x66, 0x76, 0x54, 0x81, 0xe2, 0x7a,
+	0x6b, 0x6d, 0xa9, 0xbd, 0xb6, 0x5c, 0x9d, 0x40, 0xd3, 0x00, 0xeb, 0xca, 0x95, 0xc5, 0xd6, 0xc6,
+	0x06, 0x19, 0x0b, 0xe4, 0x63, 0xeb, 0xda, 0x7a, 0x5b, 0x69, 0x2d, 0x55, 0x73, 0x68, 0x12, 0x4a,
+	0x97, 0xdb, 0x6b, 0xed, 0x8d, 0x95, 0xd6, 0x52, 0x55, 0x44, 0x53, 0x50, 0x5e, 0x6c, 0xae, 0x2d,
+	0xb6, 0x56, 0x57, 0x5b, 0x4b, 0xd5, 0x3c, 0x02, 0x28, 0x5c, 0x6e, 0xb6, 0xc9, 0x6f, 0x89, 0x60,
+	0x2d, 0xb5, 0x56, 0x9b, 0xff, 0xdd, 0x5a, 0xaa, 0x16, 0xc8, 0xe0, 0xe5, 0x66, 0x7b, 0x93, 0xd0,
+	0x2b, 0xd6, 0xbf, 0x55, 0x81, 0x32, 0x71, 0x09, 0xd6, 0xa6, 0xfc, 0x41, 0x84, 0xe2, 0xba, 0xbb,
+	0xd5, 0xef, 0xd9, 0xdb, 0xf2, 0x1f, 0x73, 0x41, 0xfa, 0x39, 0x02, 0x12, 0xf5, 0x62, 0x2e, 0x06,
+	0x1b, 0x44, 0xfc, 0x32, 0x97, 0xc9, 0x2f, 0xc5, 0xbb, 0xf5, 0xcb, 0x7c, 0xcc, 0x2f, 0x43, 0x8e,
+	0x27, 0xc5, 0x1d, 0x2f, 0xf0, 0xab, 0x42, 0xd8, 0xaf, 0x8e, 0x80, 0x44, 0x93, 0x1f, 0x75, 0xe4,
+	0x29, 0x85, 0x0d, 0x68, 0xd2, 0xb9, 0xad, 0x6d, 0xab, 0x46, 0x17, 0x73, 0x17, 0xf6, 0xc7, 0xc4,
+	0x3f, 0x2c, 0xd3, 0x25, 0x45, 0x98, 0x1a, 0x99, 0x7b, 0x30, 0x9f, 0x7a, 0x01, 0xef, 0xa1, 0x7f,
+	0x87, 0x07, 0x7a, 0x3a, 0xde, 0x1d, 0x98, 0x0e, 0x36, 0xb4, 0x3d, 0x0a, 0xc4, 0x5c, 0x79, 0x3a,
+	0x34, 0x4d, 0x00, 0xa3, 0xde, 0x52, 0x89, 0x7b, 0xcb, 0x20, 0x94, 0xcc, 0xcf, 0xc6, 0xea, 0x56,
+	0x2d, 0x49, 0x59, 0x5e, 0xd9, 0x42, 0x0b, 0xf1, 0x1e, 0x2e, 0x19, 0xc5, 0x03, 0x94, 0x3b, 0xa3,
+	0x5b, 0xa3, 0x67, 0x0f, 0xb2, 0x28, 0xf9, 0x77, 0x5e, 0xc3, 0xab, 0x05, 0x1c, 0xc2, 0xb9, 0x51,
+	0x88, 0xe6, 0xc6, 0xa0, 0x17, 0xce, 0xdd, 0x4d, 0x2f, 0x7c, 0xeb, 0x30, 0x7a, 0xe1, 0xbb, 0xd1,
+	0xe3, 0x32, 0x14, 0x16, 0x55, 0x43, 0xc3, 0xfd, 0x83, 0xb6, 0x50, 0x2f, 0xc1, 0xe4, 0x22, 0x29,
+	0xff, 0x2d, 0x56, 0x31, 0xe5, 0x7f, 0xcb, 0xa2, 0xb6, 0x0c, 0xdd, 0xc4, 0xfb, 0x02, 0x94, 0x97,
+	0xb1, 0xa3, 0x30, 0x2e, 0x29, 0x8b, 0x7c, 0x4b, 0x88, 0xd2, 0xb2, 0xb0, 0xaa, 0xef, 0xf1, 0x45,
+	0xb2, 0x41, 0xa8, 0x80, 0xe5, 0xc6, 0x2b, 0x60, 0x41, 0x02, 0x16, 0x23, 0x09, 0x38, 0x9a, 0xce,
+	0xf3, 0xf1, 0x74, 0x1e, 0xab, 0x20, 0x52, 0xbc, 0x82, 0xc8, 0x1f, 0x0a, 0x50, 0x69, 0xde, 0x52,
+	0x7b, 0x9e, 0x78, 0xe7, 0x52, 0xdb, 0x36, 0xa7, 0xb7, 0x8b, 0x4d, 0xd7, 0x61, 0xa9, 0x4a, 0xf1,
+	0x86, 0xff, 0x88, 0x82, 0xd7, 0x7f, 0x91, 0x03, 0xf1, 0x79, 0x73, 0x6b, 0x9f, 0x94, 0x47, 0xa1,
+	0xe8, 0xa8, 0xf6, 0x4e, 0xd0, 0x7c, 0x14, 0xc8, 0xb0, 0x1d, 0x6d, 0x4b, 0xc4, 0x68, 0xe8, 0x85,
+	0xd2, 0x73, 0xfe, 0x6e, 0xd3, 0xb3, 0x14, 0x4e, 0xcf, 0xb4, 0x2c, 0x98, 0x5d, 0x0b, 0xdb, 0x76,
+	0xad, 0x30, 0xa4, 0x2c, 0xb0, 0x4f, 0x64, 0xbf, 0xd8, 0x37, 0xbb, 0x76, 0xad, 0x38, 0x2b, 0x92,
+	0xfd, 0x22, 0xf9, 0x1d, 0xeb, 0x38, 0x4a, 0xf1, 0x8e, 0x83, 0xb6, 0x71, 0xfd, 0xde, 0xab, 0xd8,
+	0x0a, 0xf7, 0x14, 0x15, 0x7f, 0x6e, 0x7f, 0xd7, 0x01, 0xfb, 0xba, 0x8e, 0x7f, 0x85, 0x32, 0xa9,
+	0xf0, 0xd8, 0x22, 0xaa, 0x60, 0x99, 0xb8, 0xc4, 0x26, 0xda, 0x7a, 0xfd, 0x3d, 0x80, 0xd2, 0xf3,
+	0xe6, 0x16, 0x2b, 0x86, 0xef, 0x97, 0xa0, 0xb8, 0x68, 0x1a, 0x74, 0xd3, 0xf1, 0x91, 0x18, 0xb8,
+	0xd6, 0x1a, 0x94, 0x6d, 0x77, 0xcb, 0xd6, 0xac, 0xde, 0x16, 0xe6, 0x59, 0xa6, 0x31, 0x4c, 0x65,
+	0x1e, 0xa1, 0x06, 0x27, 0xd2, 0xd8, 0xf0, 0xb0, 0x56, 0x26, 0x94, 0x80, 0x04, 0x7a, 0x16, 0x44,
+	0x55, 0xdb, 0xe1, 0x89, 0x6f, 0x2e, 0x13, 0xa5, 0xa6, 0xb6, 0xb3, 0x32, 0xa1, 0x10, 0x34, 0xf4,
+	0x1f, 0x64, 0xdb, 0xad, 0xed, 0x50, 0xab, 0x56, 0x16, 0x1e, 0xcf, 0x84, 0xbe, 0xa6, 0x52, 0x7c,
+	0x8a, 0x88, 0x5a, 0x24, 0x23, 0xdd, 0xc0, 0x1a, 0x2b, 0xb2, 0x95, 0x85, 0x93, 0x99, 0x48, 0x28,
+	0x14, 0x65, 0x65, 0x42, 0xe1, 0xc8, 0xe8, 0x85, 0x90, 0xd5, 0x25, 0x4a, 0xe8, 0x74, 0x26, 0x42,
+	0xeb, 0x1c, 0x69, 0x65, 0x22, 0xe4, 0x1b, 0xcf, 0x82, 0xd8, 0x37, 0xbb, 0xb5, 0xc2, 0x18, 0x2a,
+	0x59, 0x35, 0xbb, 0x44, 0x25, 0x7d, 0xb3, 0x8b, 0x2e, 0x81, 0xe4, 0x98, 0xae, 0xb6, 0x4d, 0x2b,
+	0x7d, 0x65, 0xe1, 0x44, 0x26, 0xfc, 0x4d, 0x82, 0xb1, 0x32, 0xa1, 0x30, 0xd4, 0x4b, 0x65, 0x28,
+	0x92, 0x03, 0x2c, 0xd5, 0xd0, 0xe5, 0xd7, 0x43, 0xf9, 0xe1, 0x71, 0x10, 0x6f, 0x98, 0x5b, 0xdc,
+	0xea, 0x47, 0x13, 0x08, 0x2b, 0x04, 0x06, 0x5d, 0x86, 0x22, 0xd9, 0x17, 0xb9, 0x16, 0xe6, 0xa6,
+	0x3d, 0x95, 0x69, 0x1d, 0x97, 0x19, 0x8e, 0xe2, 0x21, 0xcb, 0xe7, 0xa1, 0xc8, 0xe7, 0x42, 0xfd,
+	0xad, 0x10, 0xee, 0x6f, 0x8f, 0x80, 0xc4, 0x12, 0x09, 0x6f, 0x28, 0xe9, 0x40, 0x7e, 0x05, 0xca,
+	0xbe, 0xc3, 0x91, 0x44, 0x44, 0xe3, 0x9d, 0x9c, 0x35, 0x91, 0x70, 0xe3, 0x23, 0xd2, 0xfe, 0x0c,
+	0x2c, 0x7c, 0x1d, 0x3b, 0xda, 0x36, 0xcf, 0x8b, 0xfe, 0x38, 0x1a, 0x29, 0x62, 0x34, 0x52, 0xe4,
+	0x27, 0x40, 0x6c, 0x6a, 0x3b, 0x49, 0x2b, 0x0a, 0xf2, 0x5e, 0x2e, 0x9c, 0xf7, 0xe4, 0x73, 0x90,
+	0x5f, 0x53, 0x93, 0xd1, 0x86, 0x0b, 0xf2, 0x24, 0xd9, 0xfa, 0x53, 0x1f, 0x1b, 0x0b, 0x6d, 0x05,
+	0x4a, 0x9e, 0x6f, 0x25, 0x21, 0x86, 0x33, 0x55, 0x2e, 0x31, 0x53, 0xc9, 0x0b, 0x20, 0xae, 0x9a,
+	0xdd, 0x14, 0xee, 0xfd, 0x9e, 0x81, 0x59, 0xd3, 0x50, 0x56, 0xd8, 0x40, 0x9e, 0x01, 0x89, 0x7a,
+	0x54, 0x02, 0x96, 0xfc, 0xbf, 0xa3, 0x1b, 0xb0, 0x67, 0x42, 0x7e, 0x77, 0x26, 0xd6, 0x80, 0x25,
+	0xba, 0x1e, 0x07, 0x93, 0x3f, 0x2d, 0x90, 0x0d, 0x6a, 0x2f, 0xb5, 0x2f, 0xd1, 0x42, 0x0c, 0xc2,
+	0xaa, 0x10, 0x92, 0x93, 0xf6, 0x50, 0x61, 0x23, 0x87, 0x05, 0xec, 0xd0, 0xc3, 0x1f, 0xd7, 0x3f,
+	0x97, 0x83, 0xd2, 0x86, 0xb6, 0x8d, 0x75, 0xb7, 0x8f, 0xc7, 0xd9, 0x2d, 0xcf, 0xd0, 0x26, 0x9f,
+	0x30, 0xf5, 0x0e, 0xbe, 0xca, 0x4a, 0x68, 0xe6, 0x13, 0xdb, 0x14, 0xcf, 0x40, 0xc5, 0xc0, 0xb7,
+	0x9d, 0x0e, 0xdf, 0x62, 0xf0, 0x5d, 0x31, 0x99, 0x52, 0xe8, 0x36, 0x23, 0xbd, 0x84, 0xd5, 0xbf,
+	0x58, 0x84, 0x29, 0x4f, 0x1d, 0xac, 0xcc, 0xfc, 0xf4, 0xf0, 0x8f, 0x86, 0x5f, 0x3b, 0x8c, 0x76,
+	0xf8, 0x7c, 0xbc, 0x1d, 0x7e, 0x78, 0x18, 0xae, 0x27, 0x4b, 0xd0, 0x12, 0x7f, 0x2a, 0xe7, 0x9f,
+	0x0e, 0x7f, 0x5b, 0x18, 0xb5, 0x85, 0x8c, 0x5a, 0x39, 0x97, 0x6a, 0x65, 0x31, 0x93, 0x95, 0x0f,
+	0xa5, 0x87, 0x91, 0xff, 0x33, 0xa4, 0xc1, 0x27, 0x62, 0xb1, 0x97, 0xae, 0x07, 0x2f, 0x00, 0xb5,
+	0xd1, 0x01, 0x7e, 0x70, 0x26, 0x7f, 0x0d, 0xce, 0x9f, 0xdf, 0x12, 0x12, 0x19, 0xdd, 0xc7, 0x5a,
+	0x3e, 0xac, 0x23, 0xec, 0xfa, 0xcf, 0x72, 0x50, 0x78, 0x99, 0x16, 0xb7, 0x4c, 0x37, 0x54, 0x32,
+	0x94, 0xb6, 0x4d, 0xdb, 0xa1, 0xf3, 0xbc, 0x34, 0x7a, 0xe3, 0xf0, 0x79, 0x3d, 0xeb, 0xec, 0xbd,
+	0x61, 0xa8, 0x0a, 0x4b, 0x91, 0x2a, 0x3c, 0x0b, 0x15, 0xcd, 0x34, 0x34, 0xd7, 0xb2, 0xc8, 0x81,
+	0x01, 0x6b, 0x9a, 0x95, 0xf0, 0x14, 0x7a, 0xda, 0xdf, 0x81, 0x14, 0xe9, 0x0e, 0x64, 0xe8, 0x05,
+	0x02, 0x5b, 0x7f, 0x7c, 0x0f, 0x72, 0x14, 0x8a, 0xac, 0x00, 0xd9, 0xb5, 0x12, 0xe3, 0x4a, 0x2b,
+	0x50, 0xbc, 0xd9, 0x2e, 0x0f, 0x69, 0xb6, 0xb7, 0xb1, 0x6a, 0x39, 0x5b, 0x58, 0x75, 0x82, 0x56,
+	0xba, 0xe2, 0xcf, 0x35, 0x9d, 0xfa, 0x31, 0xff, 0xcc, 0xaa, 0x0c, 0x52, 0x73, 0xb5, 0xfd, 0x52,
+	0xab, 0x3a, 0x81, 0x4a, 0x90, 0x5f, 0x6a, 0x35, 0x97, 0xaa, 0x42, 0xfd, 0xf7, 0x79, 0xa8, 0xb0,
+	0x35, 0xb1, 0x4c, 0xf7, 0xe3, 0xc3, 0xcf, 0x74, 0x7b, 0x87, 0x91, 0xe9, 0x9e, 0x88, 0x67, 0x3a,
+	0x39, 0x59, 0xbb, 0x41, 0x9e, 0xfb, 0x65, 0x70, 0x0b, 0xf6, 0x79, 0x21, 0xfd, 0x1a, 0x2c, 0xec,
+	0x2d, 0xb9, 0x64, 0x6f, 0x11, 0x93, 0xbc, 0x25, 0x9f, 0xe6, 0x2d, 0xd2, 0x3e, 0x6f, 0x91, 0x9f,
+	0x0b, 0x69, 0x65, 0x21, 0x16, 0x57, 0x69, 0xb2, 0x79, 0x51, 0xa5, 0x8e, 0xce, 0x5d, 0x07, 0x65,
+	0xf1, 0x3c, 0x94, 0x57, 0x3c, 0x4f, 0x3a, 0x68, 0xec, 0x7e, 0x57, 0x80, 0x52, 0xcb, 0x3b, 0xb4,
+	0xcb, 0x12, 0xbd, 0x51, 0xcf, 0x17, 0xe3, 0x9e, 0xff, 0x24, 0xe4, 0x9d, 0xbd, 0x01, 0xae, 0xe5,
+	0x93, 0x43, 0xcd, 0x63, 0xd7, 0xd8, 0xdc, 0x1b, 0x60, 0x85, 0x82, 0xd7, 0xcf, 0x43, 0x9e, 0x8c,
+	0xc8, 0xf9, 0xea, 0x4a, 0xab, 0xb9, 0xd4, 0x52, 0x36, 0xaa, 0x13, 0xec, 0x14, 0x76, 0xed, 0xca,
+	0xd5, 0xcd, 0xaa, 0x40, 0x7e, 0x2f, 0xb5, 0x95, 0xd6, 0xe2, 0x66, 0x35, 0x47, 0x02, 0x66, 0xf3,
+	0xca, 0x7a, 0x7b, 0xb1, 0x2a, 0xd6, 0xbf, 0x91, 0x87, 0x29, 0x8f, 0xde, 0xfd, 0xd0, 0x12, 0x78,
+	0xb2, 0x04, 0xa1, 0xf2, 0x95, 0x20, 0x54, 0x36, 0xd3, 0x23, 0xc5, 0x53, 0x7d, 0x6e, 0x2c, 0xd5,
+	0x8f, 0x5f, 0x48, 0xfc, 0x35, 0xde, 0xcb, 0x72, 0xbd, 0x8f, 0xc9, 0xa1, 0x55, 0xab, 0xcf, 0xe4,
+	0xe1, 0x01, 0x8f, 0xfa, 0xa5, 0x1e, 0xbb, 0xeb, 0x8b, 0x3b, 0xfe, 0x71, 0xa8, 0x78, 0x27, 0xd9,
+	0x41, 0x7b, 0x0d, 0xde, 0x54, 0xfa, 0x99, 0xd0, 0x73, 0x20, 0x59, 0x6e, 0x9f, 0x67, 0x9e, 0x84,
+	0x1d, 0x78, 0x8c, 0x7f, 0x43, 0x21, 0x75, 0x99, 0xa1, 0xc5, 0x02, 0x4c, 0x8a, 0x07, 0x58, 0xec,
+	0x60, 0xbd, 0x10, 0x3f, 0x58, 0x97, 0xdf, 0xcc, 0x41, 0x9e, 0xd0, 0x1b, 0x72, 0x7b, 0xf2, 0x5f,
+	0x50, 0x32, 0x07, 0xd8, 0x52, 0x1d, 0xbe, 0xdf, 0x9b, 0x5e, 0x98, 0xcf, 0xba, 0xba, 0xc6, 0x15,
+	0x8e, 0xa8, 0xf8, 0x24, 0x82, 0xcb, 0x18, 0x31, 0x7c, 0x19, 0xf3, 0xa6, 0x00, 0x25, 0x0f, 0x98,
+	0x84, 0x6d, 0xeb, 0xc5, 0xab, 0xcd, 0xd5, 0x0d, 0x76, 0x1d, 0xb3, 0x76, 0x65, 0xb3, 0xc3, 0xc7,
+	0x34, 0xa4, 0xd7, 0x95, 0xd6, 0xe5, 0xf6, 0x35, 0x16, 0xd2, 0x4a, 0x6b, 0xb9, 0x75, 0xad, 0x2a,
+	0xa2, 0x02, 0xe4, 0xda, 0x6b, 0xec, 0x0e, 0xa6, 0x75, 0xad, 0xbd, 0xb1, 0xb9, 0x51, 0x95, 0x50,
+	0x15, 0x26, 0x97, 0x95, 0x56, 0x73, 0xb3, 0xa5, 0x74, 0x36, 0x57, 0x9a, 0x6b, 0xd5, 0x02, 0x92,
+	0xe1, 0xc1, 0xf0, 0x4c, 0xe7, 0x8a, 0xe2, 0x11, 0x2e, 0x92, 0xcb, 0x9c, 0xd5, 0xd6, 0xc6, 0x06,
+	0x03, 0x2d, 0xa1, 0xa3, 0xf0, 0xcf, 0xfe, 0x30, 0x04, 0x57, 0xae, 0xbf, 0x93, 0x87, 0x23, 0x31,
+	0x59, 0x59, 0x0e, 0x79, 0x9d, 0xa7, 0x90, 0x13, 0x81, 0xa7, 0xc5, 0x3c, 0x42, 0x88, 0x7b, 0x84,
+	0xdc, 0x0e, 0xb9, 0xde, 0xc5, 0x20, 0xd6, 0x85, 0x59, 0x31, 0x29, 0x4f, 0xc4, 0x58, 0x07, 0x21,
+	0xff, 0xe7, 0x20, 0xe4, 0xbf, 0x1c, 0xaa, 0x8e, 0xe1, 0xeb, 0x16, 0x21, 0x76, 0xdd, 0xe2, 0xef,
+	0x10, 0x72, 0xe1, 0x1d, 0x82, 0xef, 0x8a, 0xe2, 0xdd, 0xb9, 0x62, 0xcc, 0xd7, 0xf2, 0xfb, 0x7c,
+	0x6d, 0x39, 0x24, 0xf4, 0x33, 0xb1, 0xb0, 0xce, 0x24, 0xb3, 0x17, 0xdd, 0x37, 0x46, 0xa7, 0x90,
+	0x43, 0xe3, 0x75, 0x68, 0x99, 0xe4, 0xc3, 0x1c, 0x94, 0x5e, 0xe6, 0x37, 0x9e, 0x99, 0x6a, 0xe7,
+	0x33, 0xb1, 0x5b, 0xec, 0x47, 0x93, 0x8a, 0x3d, 0xa1, 0x18, 0xef, 0x45, 0x2f, 0x80, 0x64, 0x98,
+	0xba, 0x9f, 0x57, 0x1e, 0x49, 0xc5, 0x5d, 0x33, 0x75, 0xac, 0x30, 0x78, 0xd4, 0x00, 0x89, 0x9c,
+	0x64, 0x7b, 0x9b, 0xf8, 0xe4, 0x9b, 0x19, 0x06, 0x16, 0x4b, 0x40, 0x85, 0x58, 0x02, 0x92, 0xd7,
+	0x21, 0x4f, 0xa8, 0x87, 0xcf, 0xcb, 0x85, 0xc8, 0x79, 0x39, 0xcf, 0x3b, 0xb9, 0x20, 0xef, 0x1c,
+	0x03, 0x18, 0xa8, 0x16, 0x36, 0x1c, 0xda, 0x49, 0x8b, 0xb4, 0x23, 0x2b, 0xb3, 0x99, 0xb6, 0x6e,
+	0xd7, 0xcf, 0x84, 0xaf, 0x6f, 0x95, 0xab, 0x6b, 0x6b, 0xec, 0xfa, 0x36, 0x7c, 0x43, 0x2b, 0x84,
+	0xae, 0x64, 0x73, 0xf5, 0x77, 0x24, 0x98, 0xf2, 0x44, 0xbd, 0x1f, 0xaa, 0xbe, 0x27, 0x4b, 0x90,
+	0x02, 0x3e, 0x0c, 0x0e, 0x02, 0xb6, 0xd2, 0xab, 0xfe, 0xa2, 0x67, 0x5d, 0x46, 0xff, 0x74, 0x1a,
+	0x7d, 0x7e, 0x5a, 0x4a, 0xe9, 0x36, 0x36, 0x06, 0x58, 0xe3, 0x26, 0x1f, 0xbf, 0x3c, 0xfb, 0x2b,
+	0xf6, 0x82, 0xea, 0x07, 0x02, 0xe4, 0x09, 0xc5, 0xe1, 0x77, 0xf6, 0x43, 0x72, 0xd3, 0x27, 0xb4,
+	0x6f, 0x26, 0xbe, 0xa8, 0x63, 0xf2, 0x4c, 0xc5, 0xee, 0xd0, 0xc7, 0x8a, 0xd4, 0x17, 0xf9, 0xcc,
+	0x15, 0xe3, 0x9e, 0xf4, 0x32, 0x71, 0x65, 0xd5, 0x3f, 0x9b, 0x03, 0xe9, 0x12, 0x79, 0x0c, 0x91,
+	0x29, 0x6b, 0x1c, 0x21, 0x47, 0xf2, 0x8e, 0xda, 0x67, 0x6a, 0x52, 0xd8, 0x00, 0x3d, 0x4c, 0x6e,
+	0x52, 0x34, 0x0d, 0x63, 0x9d, 0xbf, 0x32, 0x9a, 0x52, 0x82, 0x89, 0xd8, 0x33, 0xa3, 0x29, 0xff,
+	0x99, 0xd1, 0x1c, 0x54, 0x35, 0xb5, 0xdf, 0xdf, 0x52, 0xb5, 0x9d, 0x8e, 0x17, 0xbd, 0x2c, 0xc2,
+	0xa7, 0xbd, 0xf9, 0x4d, 0x16, 0xc5, 0xe4, 0x7e, 0xde, 0xd0, 0xfa, 0xae, 0x8e, 0x3b, 0x2c, 0xdd,
+	0xb1, 0xed, 0x73, 0x49, 0x99, 0xe6, 0xd3, 0xec, 0xde, 0x70, 0xe4, 0xbd, 0xd3, 0xa8, 0xa7, 0x2c,
+	0xf5, 0x37, 0x0a, 0x50, 0xa1, 0xca, 0xb8, 0x2f, 0x9e, 0x7b, 0x52, 0x49, 0x82, 0x38, 0xfe, 0x40,
+	0xf4, 0xe3, 0xf8, 0xe7, 0x23, 0x36, 0xba, 0xcd, 0x68, 0x20, 0x9f, 0x4c, 0xa4, 0x8e, 0x93, 0xc2,
+	0x18, 0x2d, 0x43, 0xc9, 0xb3, 0x22, 0xbf, 0xd3, 0x1a, 0x8b, 0x8a, 0x8f, 0x3c, 0xcc, 0xf8, 0xf9,
+	0x61, 0xc6, 0x97, 0x77, 0xc7, 0x7d, 0x35, 0xc8, 0xf4, 0xc3, 0x01, 0x51, 0x23, 0x2a, 0xf3, 0xa8,
+	0xd2, 0x24, 0x7f, 0xc1, 0xcb, 0x32, 0x7f, 0x57, 0x8f, 0x6a, 0xee, 0xc9, 0x63, 0xd9, 0x88, 0xa2,
+	0x16, 0xbe, 0x5a, 0x84, 0x02, 0x7b, 0x12, 0x8d, 0x54, 0x16, 0x1e, 0xe8, 0x64, 0xe2, 0xa3, 0x4c,
+	0x66, 0x59, 0x02, 0xd4, 0xe0, 0xcb, 0x91, 0x4f, 0x65, 0x03, 0xe6, 0x0b, 0xec, 0x7a, 0x4e, 0x8b,
+	0x4e, 0x8f, 0xc0, 0xe3, 0xee, 0xe3, 0xb1, 0x69, 0x64, 0x05, 0xe7, 0x8c, 0xf8, 0x61, 0xc9, 0x48,
+	0x59, 0x08, 0x50, 0x66, 0x59, 0x38, 0x70, 0x20, 0x0b, 0x3b, 0xe5, 0x1d, 0x29, 0x0b, 0x03, 0xcb,
+	0x2c, 0x8b, 0x0f, 0x1e, 0x30, 0x62, 0x6d, 0xe5, 0x48, 0x46, 0x0c, 0x2c, 0x33, 0x23, 0x1f, 0x9c,
+	0x33, 0xd2, 0xf9, 0x8b, 0x61, 0x34, 0x4a, 0x11, 0x14, 0xca, 0x67, 0x73, 0x3a, 0x23, 0x74, 0x20,
+	0x0e, 0x7b, 0x34, 0x3c, 0x52, 0x1c, 0x06, 0x96, 0x59, 0x1c, 0x1f, 0x3c, 0x24, 0x8e, 0x6b, 0x75,
+	0x33, 0x88, 0x43, 0xa0, 0xb2, 0x8b, 0xc3, 0xa1, 0x03, 0x2e, 0xf4, 0x61, 0xf0, 0x48, 0x2e, 0x14,
+	0x2a, 0x33, 0x17, 0x0f, 0x9a, 0x71, 0x59, 0xf8, 0x48, 0x02, 0x89, 0xbe, 0x08, 0x44, 0xdb, 0xfe,
+	0x63, 0xc0, 0xe1, 0xfa, 0xf3, 0xdf, 0x0d, 0x36, 0x38, 0x58, 0xba, 0xfe, 0x86, 0x81, 0x73, 0xc9,
+	0xf8, 0x73, 0x34, 0x74, 0x22, 0x1d, 0x2f, 0x12, 0x42, 0x27, 0x33, 0xc1, 0x06, 0x0c, 0x68, 0xc2,
+	0x19, 0xc1, 0x20, 0x92, 0x6f, 0x4e, 0x66, 0x82, 0xe5, 0x0c, 0xb0, 0xf7, 0x10, 0x0c, 0x9d, 0x4a,
+	0x47, 0x63, 0x50, 0xe9, 0xc6, 0x19, 0x02, 0xcd, 0xd9, 0xdc, 0x8a, 0x3e, 0x13, 0x43, 0x0b, 0x23,
+	0xd0, 0x43, 0xb0, 0x3e, 0xcb, 0x73, 0x63, 0xe1, 0x70, 0xc6, 0x46, 0xe8, 0x19, 0x19, 0x3a, 0x93,
+	0x4e, 0xc1, 0x07, 0xf4, 0x59, 0x9e, 0xcd, 0x8e, 0xc0, 0xf9, 0x39, 0x91, 0x97, 0x5d, 0x68, 0x3e,
+	0x9d, 0x40, 0x08, 0xd4, 0xe7, 0xb9, 0x30, 0x0e, 0x0a, 0xf7, 0xfd, 0x6f, 0xe6, 0x20, 0x4f, 0x5e,
+	0x64, 0xa0, 0x1b, 0xfe, 0xd3, 0x1f, 0x74, 0x2a, 0xe3, 0x7b, 0x98, 0x14, 0x7b, 0x0e, 0x81, 0x66,
+	0x0c, 0xe7, 0x84, 0xb3, 0x02, 0xfa, 0x1f, 0xee, 0xfc, 0xe9, 0x6f, 0x77, 0x22, 0xbe, 0x7f, 0x22,
+	0x0b, 0x68, 0xe0, 0xfa, 0xe4, 0x21, 0xc0, 0x08, 0xf2, 0x04, 0x24, 0x23, 0x79, 0x0e, 0xca, 0xc8,
+	0x9f, 0x15, 0x16, 0xbe, 0x9e, 0x87, 0xb2, 0x7f, 0xa5, 0x8d, 0xba, 0x3c, 0xd2, 0x1a, 0x69, 0xf7,
+	0x77, 0x43, 0xa2, 0xed, 0x4c, 0x66, 0x78, 0x2e, 0xd7, 0xae, 0x5f, 0xe0, 0xcf, 0x8e, 0x46, 0x8d,
+	0xd5, 0xf8, 0xf9, 0x31, 0x30, 0xfc, 0x5a, 0xc2, 0xac, 0x94, 0x41, 0xae, 0x88, 0xa9, 0xce, 0x64,
+	0x86, 0x0f, 0xe4, 0xe2, 0xc5, 0x3e, 0x83, 0x5c, 0xb1, 0x7a, 0x3f, 0x3f, 0x06, 0x46, 0xc0, 0x8e,
+	0x97, 0xfc, 0x0c, 0xec, 0x62, 0x55, 0x7f, 0x7e, 0x0c, 0x0c, 0x1e, 0x61, 0x6f, 0x8b, 0x50, 0xf6,
+	0x2f, 0x3b, 0xd2, 0x9d, 0x25, 0x72, 0x27, 0x92, 0xc1, 0x59, 0x86, 0xc2, 0x67, 0x73, 0x96, 0x28,
+	0x6a, 0x16, 0x67, 0x49, 0xc0, 0xc8, 0xe2, 0x2c, 0x51, 0xd4, 0xd1, 0xce, 0x32, 0x14, 0x3e, 0x9b,
+	0xf5, 0xa2, 0xa8, 0x59, 0xac, 0x97, 0x80, 0xc1, 0xad, 0xf7, 0xb1, 0x08, 0xd5, 0xf8, 0x69, 0x33,
+	0x72, 0xb9, 0x11, 0x9f, 0xcc, 0x70, 0x80, 0x39, 0xc4, 0x96, 0xe7, 0xc7, 0x45, 0xe3, 0xa2, 0xbf,
+	0xe6, 0x9b, 0xf4, 0x42, 0x66, 0x0a, 0x31, 0xcb, 0x3e, 0x35, 0x3e, 0x22, 0x67, 0xee, 0x72, 0x03,
+	0x67, 0x97, 0x39, 0x62, 0xe7, 0xf3, 0xe3, 0xa2, 0x05, 0x32, 0x73, 0x73, 0x67, 0x97, 0x39, 0x66,
+	0xf5, 0xa7, 0xc6, 0x47, 0xf4, 0x8a, 0xa3, 0x08, 0x45, 0x7e, 0x9d, 0x8f, 0x34, 0x6e, 0xf3, 0x53,
+	0xc9, 0x57, 0xbd, 0x43, 0x4c, 0x7d, 0x3a, 0x23, 0x34, 0x97, 0xb6, 0xe7, 0x5b, 0xb8, 0x31, 0x0a,
+	0x31, 0x66, 0xd8, 0x33, 0x99, 0xe1, 0x39, 0x2b, 0x7e, 0x64, 0x36, 0x5a, 0x9e, 0x88, 0x19, 0x4f,
+	0x67, 0x84, 0xe6, 0x4c, 0xac, 0xd0, 0x9d, 0x37, 0x9a, 0x1f, 0x85, 0xeb, 0x83, 0xa6, 0x77, 0x34,
+	0x49, 0x28, 0xdc, 0x68, 0x3f, 0xc9, 0x41, 0xd9, 0x3f, 0x3a, 0x4d, 0xcf, 0xb7, 0xd1, 0x13, 0xd6,
+	0xd1, 0xf9, 0x76, 0x28, 0x7c, 0xb6, 0x7c, 0x3b, 0xf4, 0x30, 0x37, 0x35, 0x2f, 0x25, 0x60, 0x64,
+	0xc9, 0xb7, 0x51, 0xd4, 0xd1, 0xf9, 0x76, 0x28, 0x3c, 0x57, 0xe7, 0xf7, 0x72, 0x50, 0xe4, 0x47,
+	0x4f, 0xe9, 0x31, 0x10, 0x3e, 0x9f, 0x1a, 0x1d, 0x03, 0x43, 0xa0, 0xb3, 0xc5, 0xc0, 0x90, 0x63,
+	0xb0, 0x54, 0xd9, 0x86, 0xc2, 0x67, 0x89, 0x81, 0x30, 0xe2, 0xe8, 0x18, 0x18, 0x02, 0xcd, 0x98,
+	0x5c, 0x7a, 0xf8, 0xdd, 0xdf, 0xce, 0x4c, 0x7c, 0xe7, 0xce, 0x8c, 0xf0, 0xfd, 0x3b, 0x33, 0xc2,
+	0xbb, 0x77, 0x66, 0x84, 0xf7, 0xee, 0xcc, 0x08, 0xbf, 0xb9, 0x33, 0x23, 0x7c, 0xe9, 0x83, 0x99,
+	0x89, 0xad, 0x02, 0xfd, 0xb7, 0x80, 0x73, 0x7f, 0x1b, 0x00, 0x8b, 0x7a, 0x75, 0xb1, 0x60, 0x40,
+	0x00, 0x00,
 }
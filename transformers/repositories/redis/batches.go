@@ -0,0 +1,216 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	batchesKeyData       string = "batches"
+	batchesKeyIndexById  string = "batches:index:id"
+	batchesSuffixTasks   string = "tasks"
+	batchesSuffixSettled string = "settled"
+)
+
+// NewBatchesRepository creates a new instance of BatchesRepository.
+func NewBatchesRepository(redisClient *redis.Client) (repo *BatchesRepository) {
+	return &BatchesRepository{
+		redisClient: redisClient,
+		tasksRepo:   NewTasksRepository(redisClient),
+	}
+}
+
+// BatchesRepository implements a Redis-based batches repository.
+// Tasks of the batches are stored by the tasks repository, see TasksRepository for details.
+// Counters of the batch are updated by the tasks repository as the tasks are settled.
+//
+// Redis schema:
+//   - HASH: `batches:<batch ID>`.
+//     Generic batch information.
+//     Fields:
+//       - `id`;
+//       - `name`;
+//       - `total`;
+//       - `succeeded`;
+//       - `failed`;
+//       - `callback_task_id`;
+//       - `include_results` (`1` or `0`);
+//       - `created_at`;
+//       - `finished_at`.
+//   - LIST: `batches:<batch ID>:tasks`.
+//     IDs of the batch tasks, in the order of submission.
+//   - SET: `batches:<batch ID>:settled`.
+//     IDs of the batch tasks that are already counted as succeeded or failed.
+//   - SORTED SET: `batches:index:id`.
+//     An index containing IDs of all known batches and creation timestamp (ms) as a score.
+type BatchesRepository struct {
+	redisClient *redis.Client    // redis client instance
+	tasksRepo   *TasksRepository // tasks repository, used to save the tasks of the batch
+}
+
+// Save persists given batch instance along with its tasks and its callback task to the repo in a single transaction.
+// Tasks are put into the pending lists of their queues, the callback task is waiting until all tasks are settled.
+func (repo *BatchesRepository) Save(
+	ctx context.Context,
+	record *models.Batch,
+	tasks []*models.Task,
+	callback *models.Task,
+) (err error) {
+
+	_, err = repo.redisClient.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) (err error) {
+		pipe.HMSet(repo.buildKey(batchesKeyData, record.Id), batchMarshal(record))
+		pipe.ZAdd(repo.buildKey(batchesKeyIndexById), redis.Z{
+			Member: record.Id,
+			Score:  float64(timeToMs(record.CreatedAt)),
+		})
+		repo.tasksRepo.save(pipe, callback)
+		ids := make([]interface{}, 0, len(tasks))
+		for _, task := range tasks {
+			repo.tasksRepo.save(pipe, task)
+			ids = append(ids, task.Id)
+		}
+		pipe.RPush(repo.buildKey(batchesKeyData, record.Id, batchesSuffixTasks), ids...)
+		return
+	})
+
+	return errors.Wrap(err, "transaction failed")
+}
+
+// GetById retrieves batch with given ID from the repo.
+func (repo *BatchesRepository) GetById(ctx context.Context, id string) (record *models.Batch, err error) {
+
+	records, err := repo.MGetById(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return records[0], nil
+}
+
+// MGetById retrieves batches with given IDs from the repo.
+// Positions of the batches that were not found are filled with nil.
+func (repo *BatchesRepository) MGetById(ctx context.Context, ids []string) (records []*models.Batch, err error) {
+
+	var dataCmds []*redis.StringStringMapCmd
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		for _, id := range ids {
+			dataCmds = append(dataCmds, pipe.HGetAll(repo.buildKey(batchesKeyData, id)))
+		}
+		return
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "pipeline failed")
+	}
+
+	for _, dataCmd := range dataCmds {
+		records = append(records, batchUnmarshal(dataCmd.Val()))
+	}
+
+	return
+}
+
+// Find returns a subset of the batches, based on collection params given.
+func (repo *BatchesRepository) Find(
+	ctx context.Context,
+	params *models.CollectionParams,
+) (records []*models.Batch, info *models.CollectionInfo, err error) {
+
+	// Parse cursor
+	cursor, err := strconv.ParseUint(params.Cursor, 10, 64)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse cursor")
+	}
+
+	// Retrieve indexes
+	var (
+		idxCmd   *redis.ScanCmd
+		countCmd *redis.IntCmd
+	)
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		key := repo.buildKey(batchesKeyIndexById)
+		idxCmd = pipe.ZScan(key, cursor, "*", int64(params.Limit))
+		countCmd = pipe.ZCard(key)
+		return
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to scan batches index")
+	}
+
+	// ZSCAN returns member/score pairs, keep members only
+	keys, newCursor := idxCmd.Val()
+	var ids []string
+	for i := 0; i < len(keys); i += 2 {
+		ids = append(ids, keys[i])
+	}
+
+	// Retrieve records
+	records, err = repo.MGetById(ctx, ids)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to retrieve records")
+	}
+	info = &models.CollectionInfo{
+		Total:  uint64(countCmd.Val()),
+		Cursor: strconv.FormatUint(newCursor, 10),
+	}
+
+	return
+}
+
+// buildKey is a helper function that builds a Redis key from key parts given.
+func (repo *BatchesRepository) buildKey(parts ...string) (key string) {
+	return strings.Join(parts, ":")
+}
+
+// batchMarshal is a helper function that marshals record into format Redis understands.
+func batchMarshal(record *models.Batch) (data map[string]interface{}) {
+
+	data = make(map[string]interface{})
+
+	data["id"] = record.Id
+	data["name"] = record.Name
+	data["total"] = strconv.FormatUint(uint64(record.Total), 10)
+	data["succeeded"] = strconv.FormatUint(uint64(record.Succeeded), 10)
+	data["failed"] = strconv.FormatUint(uint64(record.Failed), 10)
+	data["callback_task_id"] = record.CallbackTaskId
+	data["include_results"] = "0"
+	if record.IncludeResults {
+		data["include_results"] = "1"
+	}
+	data["created_at"] = record.CreatedAt.Format(time.RFC3339Nano)
+	data["finished_at"] = record.FinishedAt.Format(time.RFC3339Nano)
+
+	return
+}
+
+// batchUnmarshal is a helper function that unmarshals record from Redis format.
+// Returns nil if data is empty (record does not exist).
+func batchUnmarshal(data map[string]string) (record *models.Batch) {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	total, _ := strconv.ParseUint(data["total"], 10, 32)
+	succeeded, _ := strconv.ParseUint(data["succeeded"], 10, 32)
+	failed, _ := strconv.ParseUint(data["failed"], 10, 32)
+	createdAt, _ := time.Parse(time.RFC3339Nano, data["created_at"])
+	finishedAt, _ := time.Parse(time.RFC3339Nano, data["finished_at"])
+
+	return &models.Batch{
+		Id:             data["id"],
+		Name:           data["name"],
+		Total:          uint32(total),
+		Succeeded:      uint32(succeeded),
+		Failed:         uint32(failed),
+		CallbackTaskId: data["callback_task_id"],
+		IncludeResults: data["include_results"] == "1",
+		CreatedAt:      createdAt,
+		FinishedAt:     finishedAt,
+	}
+}
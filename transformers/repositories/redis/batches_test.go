@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gork-io/gork/models"
+)
+
+func TestBatchesRepositorySettle(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	batches := NewBatchesRepository(tasks.redisClient)
+	queue := newTestQueue(t, queues, nil)
+	callbackQueue := newTestQueue(t, queues, nil)
+
+	// Batch of three tasks, delivered in the order of creation
+	batch := models.NewBatch("batch", true)
+	var batchTasks []*models.Task
+	for i := 0; i < 3; i++ {
+		task := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, time.Time{})
+		task.CreatedAt = task.CreatedAt.Add(time.Duration(i) * time.Millisecond)
+		batch.AddTask(task)
+		batchTasks = append(batchTasks, task)
+	}
+	callback := models.NewTask(callbackQueue.Id, 0, map[string]string{}, nil, time.Time{}, time.Time{})
+	batch.SetCallback(callback)
+	if err := batches.Save(ctx, batch, batchTasks, callback); err != nil {
+		t.Fatalf("Save() failed: %v", err)
+	}
+
+	assertBatch := func(succeeded, failed uint32, finished bool) {
+		record, err := batches.GetById(ctx, batch.Id)
+		if err != nil || record == nil {
+			t.Fatalf("GetById() = %+v, %v, want batch", record, err)
+		}
+		if record.Total != 3 || record.Succeeded != succeeded || record.Failed != failed || record.FinishedAt.IsZero() == finished {
+			t.Fatalf("batch = %+v, want %d succeeded, %d failed, finished %v", record, succeeded, failed, finished)
+		}
+	}
+	assertBatch(0, 0, false)
+
+	// Finished and failed tasks are counted once, callback is waiting until all tasks are settled
+	finished, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || finished == nil || finished.Id != batchTasks[0].Id {
+		t.Fatalf("Dequeue() = %+v, %v, want task %s", finished, err, batchTasks[0].Id)
+	}
+	if ok, err := tasks.Finish(ctx, finished.Id, finished.JobId, models.TaskStatusFinished, []byte("output")); !ok || err != nil {
+		t.Fatalf("Finish() = %v, %v, want true", ok, err)
+	}
+	failed, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || failed == nil {
+		t.Fatalf("Dequeue() = %+v, %v, want task", failed, err)
+	}
+	if ok, err := tasks.Fail(ctx, failed.Id, failed.JobId, "failure"); !ok || err != nil {
+		t.Fatalf("Fail() = %v, %v, want true", ok, err)
+	}
+	if ok, err := tasks.Cancel(ctx, finished.Id); ok || err != nil {
+		t.Fatalf("Cancel() of finished task = %v, %v, want false", ok, err)
+	}
+	assertBatch(1, 1, false)
+	assertTaskStatus(t, tasks, callback.Id, models.TaskStatusWaiting)
+	if got, err := tasks.Dequeue(ctx, callbackQueue.Id); got != nil || err != nil {
+		t.Fatalf("Dequeue() of waiting callback = %+v, %v, want nothing", got, err)
+	}
+
+	// Cancelled task settles the batch, callback is enqueued with the summary
+	if ok, err := tasks.Cancel(ctx, batchTasks[2].Id); !ok || err != nil {
+		t.Fatalf("Cancel() = %v, %v, want true", ok, err)
+	}
+	assertBatch(1, 2, true)
+	got, err := tasks.Dequeue(ctx, callbackQueue.Id)
+	if err != nil || got == nil || got.Id != callback.Id || got.Headers[models.TaskHeaderBatchId] != batch.Id {
+		t.Fatalf("Dequeue() of callback = %+v, %v, want task %s", got, err, callback.Id)
+	}
+	var summary struct {
+		BatchId   string            `json:"batch_id"`
+		Total     int               `json:"total"`
+		Succeeded int               `json:"succeeded"`
+		Failed    int               `json:"failed"`
+		Results   map[string]string `json:"results"`
+	}
+	if err := json.Unmarshal(got.Input, &summary); err != nil {
+		t.Fatalf("callback input %s is not a summary: %v", got.Input, err)
+	}
+	if summary.BatchId != batch.Id || summary.Total != 3 || summary.Succeeded != 1 || summary.Failed != 2 ||
+		len(summary.Results) != 1 || summary.Results[finished.Id] != "output" {
+		t.Fatalf("callback summary = %+v, want 1 succeeded with output and 2 failed", summary)
+	}
+}
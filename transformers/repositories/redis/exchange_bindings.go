@@ -0,0 +1,239 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	exchangeBindingsKeyData          string = "exchange-bindings"
+	exchangeBindingsKeyExchangeIndex string = "exchanges"
+	exchangeBindingsSuffixIndex      string = "bindings"
+	exchangeBindingsSuffixVersion    string = "bindings-version"
+)
+
+// NewExchangeBindingsRepository creates a new instance of ExchangeBindingsRepository.
+func NewExchangeBindingsRepository(redisClient *redis.Client) (repo *ExchangeBindingsRepository) {
+	return &ExchangeBindingsRepository{
+		redisClient: redisClient,
+	}
+}
+
+// ExchangeBindingsRepository implements a Redis-based exchange bindings repository.
+//
+// Redis schema:
+//   - HASH: `exchange-bindings:<binding ID>`.
+//     Generic binding information.
+//     Fields:
+//       - `id`;
+//       - `exchange_id`;
+//       - `queue_id`;
+//       - `routing_key`;
+//       - `rules` (JSON-encoded list of the routing rules);
+//       - `created_at`.
+//   - SORTED SET: `exchanges:<exchange ID>:bindings`.
+//     An index containing IDs of all bindings of the exchange and creation timestamp (ms) as a score.
+//   - STRING: `exchanges:<exchange ID>:bindings-version`.
+//     A counter that is incremented every time a binding of the exchange is added or removed.
+type ExchangeBindingsRepository struct {
+	redisClient *redis.Client // redis client instance
+}
+
+// Save persists given binding instance to the repo.
+func (repo *ExchangeBindingsRepository) Save(ctx context.Context, record *models.ExchangeBinding) (err error) {
+
+	data, err := exchangeBindingMarshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal record")
+	}
+	_, err = repo.redisClient.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) (err error) {
+		pipe.HMSet(repo.buildKey(exchangeBindingsKeyData, record.Id), data)
+		pipe.ZAdd(repo.buildKey(exchangeBindingsKeyExchangeIndex, record.ExchangeId, exchangeBindingsSuffixIndex), redis.Z{
+			Member: record.Id,
+			Score:  float64(timeToMs(record.CreatedAt)),
+		})
+		pipe.Incr(repo.buildKey(exchangeBindingsKeyExchangeIndex, record.ExchangeId, exchangeBindingsSuffixVersion))
+		return
+	})
+
+	return errors.Wrap(err, "transaction failed")
+}
+
+// Delete removes binding with given ID from the repo.
+func (repo *ExchangeBindingsRepository) Delete(ctx context.Context, id string) (err error) {
+
+	clientCtx := repo.redisClient.WithContext(ctx)
+
+	// Retrieve exchange ID
+	exchangeIdCmd := clientCtx.HGet(repo.buildKey(exchangeBindingsKeyData, id), "exchange_id")
+	if exchangeIdCmd.Err() == redis.Nil {
+		return nil
+	}
+	err = errors.Wrap(exchangeIdCmd.Err(), "failed to retrieve exchange id")
+	if err != nil {
+		return err
+	}
+
+	// Delete all binding data
+	_, err = clientCtx.TxPipelined(func(pipe redis.Pipeliner) (err error) {
+		pipe.ZRem(repo.buildKey(exchangeBindingsKeyExchangeIndex, exchangeIdCmd.Val(), exchangeBindingsSuffixIndex), id)
+		pipe.Del(repo.buildKey(exchangeBindingsKeyData, id))
+		pipe.Incr(repo.buildKey(exchangeBindingsKeyExchangeIndex, exchangeIdCmd.Val(), exchangeBindingsSuffixVersion))
+		return
+	})
+
+	return errors.Wrap(err, "transaction failed")
+}
+
+// GetById retrieves binding with given ID from the repo.
+func (repo *ExchangeBindingsRepository) GetById(ctx context.Context, id string) (record *models.ExchangeBinding, err error) {
+
+	records, err := repo.mGetById(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return records[0], nil
+}
+
+// FindByExchange returns all bindings of the exchange with given ID.
+func (repo *ExchangeBindingsRepository) FindByExchange(
+	ctx context.Context,
+	exchangeId string,
+) (records []*models.ExchangeBinding, err error) {
+
+	// Retrieve index
+	ids, err := repo.redisClient.WithContext(ctx).ZRange(
+		repo.buildKey(exchangeBindingsKeyExchangeIndex, exchangeId, exchangeBindingsSuffixIndex),
+		0,
+		-1,
+	).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to range bindings index")
+	}
+
+	// Retrieve records, skipping the ones that are gone in the meantime
+	found, err := repo.mGetById(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve records")
+	}
+	for _, record := range found {
+		if record != nil {
+			records = append(records, record)
+		}
+	}
+
+	return
+}
+
+// GetVersion returns a counter that changes every time a binding of the exchange with given ID is added or removed.
+func (repo *ExchangeBindingsRepository) GetVersion(ctx context.Context, exchangeId string) (version int64, err error) {
+
+	version, err = repo.redisClient.WithContext(ctx).Get(
+		repo.buildKey(exchangeBindingsKeyExchangeIndex, exchangeId, exchangeBindingsSuffixVersion),
+	).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+
+	return version, errors.Wrap(err, "failed to retrieve version")
+}
+
+// mGetById is a helper function that retrieves bindings with given IDs from the repo.
+// Positions of the bindings that were not found are filled with nil.
+func (repo *ExchangeBindingsRepository) mGetById(ctx context.Context, ids []string) (records []*models.ExchangeBinding, err error) {
+
+	var dataCmds []*redis.StringStringMapCmd
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		for _, id := range ids {
+			dataCmds = append(dataCmds, pipe.HGetAll(repo.buildKey(exchangeBindingsKeyData, id)))
+		}
+		return
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "pipeline failed")
+	}
+
+	for _, dataCmd := range dataCmds {
+		records = append(records, exchangeBindingUnmarshal(dataCmd.Val()))
+	}
+
+	return
+}
+
+// buildKey is a helper function that builds a Redis key from key parts given.
+func (repo *ExchangeBindingsRepository) buildKey(parts ...string) (key string) {
+	return strings.Join(parts, ":")
+}
+
+// exchangeBindingRuleData represents a routing rule in the format it is stored in Redis.
+type exchangeBindingRuleData struct {
+	Key      string `json:"key"`
+	Operator uint8  `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// exchangeBindingMarshal is a helper function that marshals record into format Redis understands.
+func exchangeBindingMarshal(record *models.ExchangeBinding) (data map[string]interface{}, err error) {
+
+	rulesData := make([]exchangeBindingRuleData, 0, len(record.Rules))
+	for _, rule := range record.Rules {
+		rulesData = append(rulesData, exchangeBindingRuleData{
+			Key:      rule.Key,
+			Operator: uint8(rule.Operator),
+			Value:    rule.Value,
+		})
+	}
+	rules, err := json.Marshal(rulesData)
+	if err != nil {
+		return nil, err
+	}
+
+	data = make(map[string]interface{})
+
+	data["id"] = record.Id
+	data["exchange_id"] = record.ExchangeId
+	data["queue_id"] = record.QueueId
+	data["routing_key"] = record.RoutingKey
+	data["rules"] = string(rules)
+	data["created_at"] = record.CreatedAt.Format(time.RFC3339Nano)
+
+	return
+}
+
+// exchangeBindingUnmarshal is a helper function that unmarshals record from Redis format.
+// Returns nil if data is empty (record does not exist).
+func exchangeBindingUnmarshal(data map[string]string) (record *models.ExchangeBinding) {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	var rulesData []exchangeBindingRuleData
+	json.Unmarshal([]byte(data["rules"]), &rulesData)
+	createdAt, _ := time.Parse(time.RFC3339Nano, data["created_at"])
+
+	record = &models.ExchangeBinding{
+		Id:         data["id"],
+		ExchangeId: data["exchange_id"],
+		QueueId:    data["queue_id"],
+		RoutingKey: data["routing_key"],
+		CreatedAt:  createdAt,
+	}
+	for _, rule := range rulesData {
+		record.Rules = append(record.Rules, &models.ExchangeBindingRule{
+			Key:      rule.Key,
+			Operator: models.ExchangeBindingRuleOperator(rule.Operator),
+			Value:    rule.Value,
+		})
+	}
+
+	return
+}
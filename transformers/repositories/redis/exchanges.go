@@ -0,0 +1,223 @@
+package redis
+
+import (
+	"context"
+
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	exchangesKeyData        string = "exchanges"
+	exchangesKeyIndexById   string = "exchanges:index:id"
+	exchangesKeyIndexByName string = "exchanges:index:name"
+)
+
+// NewExchangesRepository creates a new instance of ExchangesRepository.
+func NewExchangesRepository(redisClient *redis.Client) (repo *ExchangesRepository) {
+	return &ExchangesRepository{
+		redisClient: redisClient,
+	}
+}
+
+// ExchangesRepository implements a Redis-based exchanges repository.
+//
+// Redis schema:
+//   - HASH: `exchanges:<exchange ID>`.
+//     Generic exchange information.
+//     Fields:
+//       - `id`;
+//       - `name`;
+//       - `type`;
+//       - `created_at`.
+//   - SORTED SET: `exchanges:index:id`.
+//     An index containing IDs of all known exchanges and creation timestamp (ms) as a score.
+//   - STRING: `exchanges:index:name:<exchange name>`.
+//     An index containing Name => ID pairs of all known exchanges.
+type ExchangesRepository struct {
+	redisClient *redis.Client // redis client instance
+}
+
+// Save persists given exchange instance to the repo.
+func (repo *ExchangesRepository) Save(ctx context.Context, record *models.Exchange) (err error) {
+
+	data := exchangeMarshal(record)
+	_, err = repo.redisClient.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) (err error) {
+		pipe.HMSet(repo.buildKey(exchangesKeyData, record.Id), data)
+		pipe.ZAdd(repo.buildKey(exchangesKeyIndexById), redis.Z{
+			Member: record.Id,
+			Score:  float64(timeToMs(record.CreatedAt)),
+		})
+		pipe.Set(repo.buildKey(exchangesKeyIndexByName, record.Name), record.Id, 0)
+		return
+	})
+
+	return errors.Wrap(err, "transaction failed")
+}
+
+// Delete removes exchange with given ID from the repo.
+func (repo *ExchangesRepository) Delete(ctx context.Context, id string) (err error) {
+
+	clientCtx := repo.redisClient.WithContext(ctx)
+
+	// Retrieve exchange name
+	nameCmd := clientCtx.HGet(repo.buildKey(exchangesKeyData, id), "name")
+	if nameCmd.Err() == redis.Nil {
+		return nil
+	}
+	err = errors.Wrap(nameCmd.Err(), "failed to retrieve exchange name")
+	if err != nil {
+		return err
+	}
+
+	// Delete all exchange data, including what is left of the bindings index
+	_, err = clientCtx.TxPipelined(func(pipe redis.Pipeliner) (err error) {
+		pipe.ZRem(repo.buildKey(exchangesKeyIndexById), id)
+		pipe.Del(
+			repo.buildKey(exchangesKeyIndexByName, nameCmd.Val()),
+			repo.buildKey(exchangesKeyData, id),
+			repo.buildKey(exchangesKeyData, id, exchangeBindingsSuffixIndex),
+			repo.buildKey(exchangesKeyData, id, exchangeBindingsSuffixVersion),
+		)
+		return
+	})
+
+	return errors.Wrap(err, "transaction failed")
+}
+
+// GetById retrieves exchange with given ID from the repo.
+func (repo *ExchangesRepository) GetById(ctx context.Context, id string) (record *models.Exchange, err error) {
+
+	records, err := repo.MGetById(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return records[0], nil
+}
+
+// GetByName retrieves exchange with given name from the repo.
+func (repo *ExchangesRepository) GetByName(ctx context.Context, name string) (record *models.Exchange, err error) {
+
+	// Find ID by name
+	id, err := repo.redisClient.WithContext(ctx).Get(repo.buildKey(exchangesKeyIndexByName, name)).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve exchange id")
+	}
+
+	return repo.GetById(ctx, id)
+}
+
+// MGetById retrieves exchanges with given IDs from the repo.
+// Positions of the exchanges that were not found are filled with nil.
+func (repo *ExchangesRepository) MGetById(ctx context.Context, ids []string) (records []*models.Exchange, err error) {
+
+	var dataCmds []*redis.StringStringMapCmd
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		for _, id := range ids {
+			dataCmds = append(dataCmds, pipe.HGetAll(repo.buildKey(exchangesKeyData, id)))
+		}
+		return
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "pipeline failed")
+	}
+
+	for _, dataCmd := range dataCmds {
+		records = append(records, exchangeUnmarshal(dataCmd.Val()))
+	}
+
+	return
+}
+
+// Find returns a subset of the exchanges, based on collection params given.
+func (repo *ExchangesRepository) Find(
+	ctx context.Context,
+	params *models.CollectionParams,
+) (records []*models.Exchange, info *models.CollectionInfo, err error) {
+
+	// Parse cursor
+	cursor, err := strconv.ParseUint(params.Cursor, 10, 64)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse cursor")
+	}
+
+	// Retrieve indexes
+	var (
+		idxCmd   *redis.ScanCmd
+		countCmd *redis.IntCmd
+	)
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		key := repo.buildKey(exchangesKeyIndexById)
+		idxCmd = pipe.ZScan(key, cursor, "*", int64(params.Limit))
+		countCmd = pipe.ZCard(key)
+		return
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to scan exchanges index")
+	}
+
+	// ZSCAN returns member/score pairs, keep members only
+	keys, newCursor := idxCmd.Val()
+	var ids []string
+	for i := 0; i < len(keys); i += 2 {
+		ids = append(ids, keys[i])
+	}
+
+	// Retrieve records
+	records, err = repo.MGetById(ctx, ids)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to retrieve records")
+	}
+	info = &models.CollectionInfo{
+		Total:  uint64(countCmd.Val()),
+		Cursor: strconv.FormatUint(newCursor, 10),
+	}
+
+	return
+}
+
+// buildKey is a helper function that builds a Redis key from key parts given.
+func (repo *ExchangesRepository) buildKey(parts ...string) (key string) {
+	return strings.Join(parts, ":")
+}
+
+// exchangeMarshal is a helper function that marshals record into format Redis understands.
+func exchangeMarshal(record *models.Exchange) (data map[string]interface{}) {
+
+	data = make(map[string]interface{})
+
+	data["id"] = record.Id
+	data["name"] = record.Name
+	data["type"] = strconv.Itoa(int(record.Type))
+	data["created_at"] = record.CreatedAt.Format(time.RFC3339Nano)
+
+	return
+}
+
+// exchangeUnmarshal is a helper function that unmarshals record from Redis format.
+// Returns nil if data is empty (record does not exist).
+func exchangeUnmarshal(data map[string]string) (record *models.Exchange) {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	exchangeType, _ := strconv.Atoi(data["type"])
+	createdAt, _ := time.Parse(time.RFC3339Nano, data["created_at"])
+
+	return &models.Exchange{
+		Id:        data["id"],
+		Name:      data["name"],
+		Type:      models.ExchangeType(exchangeType),
+		CreatedAt: createdAt,
+	}
+}
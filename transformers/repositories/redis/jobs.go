@@ -0,0 +1,256 @@
+package redis
+
+import (
+	"context"
+
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	jobsKeyData       string = "jobs"
+	jobsSuffixHeaders string = "headers"
+	jobsSuffixLogs    string = "logs"
+)
+
+var (
+	// jobsScriptFinish sets finish time of the job, if it exists and is not finished yet.
+	//
+	// KEYS[1] - job data key;
+	// ARGV[1] - finish time.
+	jobsScriptFinish = redis.NewScript(`
+		local finishedAt = redis.call('HGET', KEYS[1], 'finished_at')
+		if finishedAt ~= '' then
+			return 0
+		end
+		redis.call('HSET', KEYS[1], 'finished_at', ARGV[1])
+		return 1
+	`)
+
+	// jobsScriptUpdateProgress sets progress of the job, if it exists and is not finished yet.
+	//
+	// KEYS[1] - job data key;
+	// ARGV[1] - progress.
+	jobsScriptUpdateProgress = redis.NewScript(`
+		local finishedAt = redis.call('HGET', KEYS[1], 'finished_at')
+		if finishedAt ~= '' then
+			return 0
+		end
+		redis.call('HSET', KEYS[1], 'progress', ARGV[1])
+		return 1
+	`)
+
+	// jobsScriptAppendLogs appends lines to the log of the job, if it exists and is not finished yet.
+	//
+	// KEYS[1] - job data key;
+	// KEYS[2] - job logs key;
+	// ARGV[...] - log lines.
+	jobsScriptAppendLogs = redis.NewScript(`
+		local finishedAt = redis.call('HGET', KEYS[1], 'finished_at')
+		if finishedAt ~= '' then
+			return 0
+		end
+		redis.call('RPUSH', KEYS[2], unpack(ARGV))
+		return 1
+	`)
+)
+
+// NewJobsRepository creates a new instance of JobsRepository.
+func NewJobsRepository(redisClient *redis.Client) (repo *JobsRepository) {
+	return &JobsRepository{
+		redisClient: redisClient,
+	}
+}
+
+// JobsRepository implements a Redis-based jobs repository.
+//
+// Redis schema:
+//   - HASH: `jobs:<job ID>`.
+//     Generic job information.
+//     Fields:
+//       - `id`;
+//       - `task_id`;
+//       - `queue_id`;
+//       - `worker_id`;
+//       - `input`;
+//       - `progress`;
+//       - `created_at`;
+//       - `delivered_at`;
+//       - `finished_at` (an empty string until the job is finished).
+//   - HASH: `jobs:<job ID>:headers`.
+//     Task headers data.
+//   - LIST: `jobs:<job ID>:logs`.
+//     Log lines reported by the worker.
+type JobsRepository struct {
+	redisClient *redis.Client // redis client instance
+}
+
+// Save persists given job instance to the repo.
+func (repo *JobsRepository) Save(ctx context.Context, record *models.Job) (err error) {
+
+	data, headersData := jobMarshal(record)
+	_, err = repo.redisClient.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) (err error) {
+		pipe.HMSet(repo.buildKey(jobsKeyData, record.Id), data)
+		if len(headersData) > 0 {
+			pipe.HMSet(repo.buildKey(jobsKeyData, record.Id, jobsSuffixHeaders), headersData)
+		}
+		return
+	})
+
+	return errors.Wrap(err, "transaction failed")
+}
+
+// GetById retrieves job with given ID from the repo.
+func (repo *JobsRepository) GetById(ctx context.Context, id string) (record *models.Job, err error) {
+
+	var (
+		dataCmd        *redis.StringStringMapCmd
+		headersDataCmd *redis.StringStringMapCmd
+		logsCmd        *redis.StringSliceCmd
+	)
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		dataCmd = pipe.HGetAll(repo.buildKey(jobsKeyData, id))
+		headersDataCmd = pipe.HGetAll(repo.buildKey(jobsKeyData, id, jobsSuffixHeaders))
+		logsCmd = pipe.LRange(repo.buildKey(jobsKeyData, id, jobsSuffixLogs), 0, -1)
+		return
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "pipeline failed")
+	}
+
+	record = jobUnmarshal(dataCmd.Val(), headersDataCmd.Val(), logsCmd.Val())
+	return
+}
+
+// Finish atomically sets finish time of the job with given ID, if it is not finished yet.
+// Returns false if the job does not exist or is already finished.
+func (repo *JobsRepository) Finish(ctx context.Context, id string, finishedAt time.Time) (finished bool, err error) {
+
+	result, err := jobsScriptFinish.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{repo.buildKey(jobsKeyData, id)},
+		finishedAt.Format(time.RFC3339Nano),
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "finish script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// UpdateProgress atomically sets progress of the job with given ID, if it is not finished yet.
+// Returns false if the job does not exist or is already finished.
+func (repo *JobsRepository) UpdateProgress(ctx context.Context, id string, progress uint8) (updated bool, err error) {
+
+	result, err := jobsScriptUpdateProgress.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{repo.buildKey(jobsKeyData, id)},
+		strconv.Itoa(int(progress)),
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "update progress script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// AppendLogs atomically appends lines to the log of the job with given ID, if it is not finished yet.
+// Returns false if the job does not exist or is already finished.
+func (repo *JobsRepository) AppendLogs(ctx context.Context, id string, lines []string) (appended bool, err error) {
+
+	args := make([]interface{}, 0, len(lines))
+	for _, line := range lines {
+		args = append(args, line)
+	}
+
+	result, err := jobsScriptAppendLogs.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{
+			repo.buildKey(jobsKeyData, id),
+			repo.buildKey(jobsKeyData, id, jobsSuffixLogs),
+		},
+		args...,
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "append logs script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// GetLogs retrieves log lines of the job with given ID, starting from given offset.
+func (repo *JobsRepository) GetLogs(ctx context.Context, id string, offset int) (lines []string, err error) {
+
+	lines, err = repo.redisClient.WithContext(ctx).LRange(repo.buildKey(jobsKeyData, id, jobsSuffixLogs), int64(offset), -1).Result()
+
+	return lines, errors.Wrap(err, "failed to retrieve logs")
+}
+
+// buildKey is a helper function that builds a Redis key from key parts given.
+func (repo *JobsRepository) buildKey(parts ...string) (key string) {
+	return strings.Join(parts, ":")
+}
+
+// jobMarshal is a helper function that marshals record into format Redis understands.
+func jobMarshal(record *models.Job) (data, headersData map[string]interface{}) {
+
+	data = make(map[string]interface{})
+	headersData = make(map[string]interface{})
+
+	data["id"] = record.Id
+	data["task_id"] = record.TaskId
+	data["queue_id"] = record.QueueId
+	data["worker_id"] = record.WorkerId
+	data["input"] = record.Input
+	data["progress"] = strconv.Itoa(int(record.Progress))
+	data["created_at"] = record.CreatedAt.Format(time.RFC3339Nano)
+	data["delivered_at"] = record.DeliveredAt.Format(time.RFC3339Nano)
+	data["finished_at"] = ""
+	if !record.FinishedAt.IsZero() {
+		data["finished_at"] = record.FinishedAt.Format(time.RFC3339Nano)
+	}
+
+	for key, value := range record.Headers {
+		headersData[key] = value
+	}
+
+	return
+}
+
+// jobUnmarshal is a helper function that unmarshals record from Redis format.
+// Returns nil if data is empty (record does not exist).
+func jobUnmarshal(data, headersData map[string]string, logs []string) (record *models.Job) {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	progress, _ := strconv.Atoi(data["progress"])
+	createdAt, _ := time.Parse(time.RFC3339Nano, data["created_at"])
+	deliveredAt, _ := time.Parse(time.RFC3339Nano, data["delivered_at"])
+	finishedAt, _ := time.Parse(time.RFC3339Nano, data["finished_at"])
+
+	record = &models.Job{
+		Id:          data["id"],
+		TaskId:      data["task_id"],
+		QueueId:     data["queue_id"],
+		WorkerId:    data["worker_id"],
+		Headers:     make(map[string]string),
+		Input:       []byte(data["input"]),
+		Progress:    uint8(progress),
+		Logs:        logs,
+		CreatedAt:   createdAt,
+		DeliveredAt: deliveredAt,
+		FinishedAt:  finishedAt,
+	}
+	for key, value := range headersData {
+		record.Headers[key] = value
+	}
+
+	return
+}
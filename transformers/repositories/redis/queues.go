@@ -21,6 +21,42 @@ const (
 	queuesKeyIndexByName string = "queues:index:name"
 )
 
+var (
+	// queuesScriptUpdateSettings overwrites the settings of the queue and increments its version,
+	// if the queue exists and is still at given version.
+	//
+	// KEYS[1] - queue data key;
+	// KEYS[2] - queue settings key;
+	// ARGV[1] - expected version;
+	// ARGV[2...] - setting name/value pairs.
+	queuesScriptUpdateSettings = redis.NewScript(`
+		if redis.call('EXISTS', KEYS[1]) == 0 then
+			return 0
+		end
+		local version = tonumber(redis.call('HGET', KEYS[1], 'version')) or 0
+		if version ~= tonumber(ARGV[1]) then
+			return 0
+		end
+		if #ARGV > 1 then
+			redis.call('HMSET', KEYS[2], unpack(ARGV, 2))
+		end
+		redis.call('HSET', KEYS[1], 'version', version + 1)
+		return 1
+	`)
+
+	// queuesScriptSetPaused sets paused state of the queue, if it exists.
+	//
+	// KEYS[1] - queue data key;
+	// ARGV[1] - paused state.
+	queuesScriptSetPaused = redis.NewScript(`
+		if redis.call('EXISTS', KEYS[1]) == 0 then
+			return 0
+		end
+		redis.call('HSET', KEYS[1], 'paused', ARGV[1])
+		return 1
+	`)
+)
+
 // NewQueuesRepository creates a new instance of QueuesRepository.
 func NewQueuesRepository(redisClient *redis.Client) (repo *QueuesRepository) {
 	return &QueuesRepository{
@@ -36,6 +72,8 @@ func NewQueuesRepository(redisClient *redis.Client) (repo *QueuesRepository) {
 //     Fields:
 //       - `id`;
 //       - `name`;
+//       - `paused` (`1` if the queue holds its tasks back from the consumers, `0` otherwise);
+//       - `version` (incremented on every settings update);
 //       - `created_at`.
 //   - HASH: `queues:<queue ID>:settings`.
 //     Queue settings data.
@@ -119,6 +157,9 @@ func (repo *QueuesRepository) GetByName(ctx context.Context, name string) (recor
 
 	// Find ID by name
 	idCmd := clientCtx.Get(repo.buildKey(queuesKeyIndexByName, name))
+	if idCmd.Err() == redis.Nil {
+		return nil, nil
+	}
 	err = errors.Wrap(idCmd.Err(), "failed to retrieve queue id")
 	if err != nil {
 		return
@@ -193,9 +234,15 @@ func (repo *QueuesRepository) Find(
 		return nil, nil, errors.Wrap(err, "failed to scan queues index")
 	}
 
-	// Retrieve records
+	// ZSCAN returns member/score pairs, keep members only
 	keys, newCursor := idxCmd.Val()
-	records, err = repo.MGetById(ctx, keys)
+	var ids []string
+	for i := 0; i < len(keys); i += 2 {
+		ids = append(ids, keys[i])
+	}
+
+	// Retrieve records
+	records, err = repo.MGetById(ctx, ids)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to retrieve records")
 	}
@@ -207,6 +254,55 @@ func (repo *QueuesRepository) Find(
 	return
 }
 
+// UpdateSettings overwrites given settings of the queue with given ID and increments its version,
+// if the queue is still at given version. Settings that are not given are kept intact.
+// Returns false if the queue does not exist or its version differs.
+func (repo *QueuesRepository) UpdateSettings(
+	ctx context.Context,
+	id string,
+	settings map[models.QueueSetting]string,
+	version int64,
+) (updated bool, err error) {
+
+	args := []interface{}{version}
+	for key, value := range settings {
+		args = append(args, string(key), value)
+	}
+	result, err := queuesScriptUpdateSettings.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{
+			repo.buildKey(queuesKeyData, id),
+			repo.buildKey(queuesKeyData, id, queuesSuffixSettings),
+		},
+		args...,
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "update settings script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// SetPaused pauses or resumes delivery of the tasks of the queue with given ID.
+// Returns false if the queue does not exist.
+func (repo *QueuesRepository) SetPaused(ctx context.Context, id string, paused bool) (updated bool, err error) {
+
+	state := "0"
+	if paused {
+		state = "1"
+	}
+	result, err := queuesScriptSetPaused.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{repo.buildKey(queuesKeyData, id)},
+		state,
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "set paused script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
 // buildKey is a helper function that builds a Redis key from key parts given.
 func (repo *QueuesRepository) buildKey(parts ...string) (key string) {
 	return strings.Join(parts, ":")
@@ -220,6 +316,11 @@ func queueMarshal(record *models.Queue) (data, settingsData map[string]interface
 
 	data["id"] = record.Id
 	data["name"] = record.Name
+	data["paused"] = "0"
+	if record.Paused {
+		data["paused"] = "1"
+	}
+	data["version"] = strconv.FormatInt(record.Version, 10)
 	data["created_at"] = record.CreatedAt.Format(time.RFC3339Nano)
 
 	for key, value := range record.Settings {
@@ -230,13 +331,22 @@ func queueMarshal(record *models.Queue) (data, settingsData map[string]interface
 }
 
 // queueUnmarshal is a helper function that unmarshals record from Redis format.
+// Returns nil if data is empty (record does not exist).
 func queueUnmarshal(data, settingsData map[string]string) (record *models.Queue) {
 
+	if len(data) == 0 {
+		return nil
+	}
+
+	version, _ := strconv.ParseInt(data["version"], 10, 64)
 	createdAt, _ := time.Parse(time.RFC3339Nano, data["created_at"])
 
 	record = &models.Queue{
 		Id:        data["id"],
 		Name:      data["name"],
+		Settings:  make(map[models.QueueSetting]string),
+		Paused:    data["paused"] == "1",
+		Version:   version,
 		CreatedAt: createdAt,
 	}
 	for key, value := range settingsData {
@@ -0,0 +1,298 @@
+package redis
+
+import (
+	"context"
+
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	schedulesKeyData        string = "schedules"
+	schedulesSuffixHeaders  string = "headers"
+	schedulesKeyIndexById   string = "schedules:index:id"
+	schedulesKeyIndexByNext string = "schedules:index:next"
+)
+
+var (
+	// schedulesScriptAdvance moves next run time of the schedule, if it is not moved by someone else yet.
+	//
+	// KEYS[1] - schedule data key;
+	// KEYS[2] - next run time index;
+	// ARGV[1] - schedule ID;
+	// ARGV[2] - previous next run time (ms);
+	// ARGV[3] - new next run time (ms);
+	// ARGV[4] - new next run time.
+	schedulesScriptAdvance = redis.NewScript(`
+		local score = redis.call('ZSCORE', KEYS[2], ARGV[1])
+		if not score or tonumber(score) ~= tonumber(ARGV[2]) then
+			return 0
+		end
+		redis.call('ZADD', KEYS[2], ARGV[3], ARGV[1])
+		redis.call('HSET', KEYS[1], 'next_run_at', ARGV[4])
+		return 1
+	`)
+)
+
+// NewSchedulesRepository creates a new instance of SchedulesRepository.
+func NewSchedulesRepository(redisClient *redis.Client) (repo *SchedulesRepository) {
+	return &SchedulesRepository{
+		redisClient: redisClient,
+	}
+}
+
+// SchedulesRepository implements a Redis-based schedules repository.
+//
+// Redis schema:
+//   - HASH: `schedules:<schedule ID>`.
+//     Generic schedule information.
+//     Fields:
+//       - `id`;
+//       - `queue_id`;
+//       - `expression`;
+//       - `priority`;
+//       - `input`;
+//       - `next_run_at`;
+//       - `created_at`.
+//   - HASH: `schedules:<schedule ID>:headers`.
+//     Task headers data.
+//   - SORTED SET: `schedules:index:id`.
+//     An index containing IDs of all known schedules and creation timestamp (ms) as a score.
+//   - SORTED SET: `schedules:index:next`.
+//     An index containing IDs of all known schedules and next run timestamp (ms) as a score.
+type SchedulesRepository struct {
+	redisClient *redis.Client // redis client instance
+}
+
+// Save persists given schedule instance to the repo.
+func (repo *SchedulesRepository) Save(ctx context.Context, record *models.Schedule) (err error) {
+
+	data, headersData := scheduleMarshal(record)
+	_, err = repo.redisClient.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) (err error) {
+		pipe.HMSet(repo.buildKey(schedulesKeyData, record.Id), data)
+		pipe.Del(repo.buildKey(schedulesKeyData, record.Id, schedulesSuffixHeaders))
+		if len(headersData) > 0 {
+			pipe.HMSet(repo.buildKey(schedulesKeyData, record.Id, schedulesSuffixHeaders), headersData)
+		}
+		pipe.ZAdd(repo.buildKey(schedulesKeyIndexById), redis.Z{
+			Member: record.Id,
+			Score:  float64(timeToMs(record.CreatedAt)),
+		})
+		pipe.ZAdd(repo.buildKey(schedulesKeyIndexByNext), redis.Z{
+			Member: record.Id,
+			Score:  float64(timeToMs(record.NextRunAt)),
+		})
+		return
+	})
+
+	return errors.Wrap(err, "transaction failed")
+}
+
+// Delete removes schedule with given ID from the repo.
+func (repo *SchedulesRepository) Delete(ctx context.Context, id string) (err error) {
+
+	_, err = repo.redisClient.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) (err error) {
+		pipe.ZRem(repo.buildKey(schedulesKeyIndexById), id)
+		pipe.ZRem(repo.buildKey(schedulesKeyIndexByNext), id)
+		pipe.Del(
+			repo.buildKey(schedulesKeyData, id),
+			repo.buildKey(schedulesKeyData, id, schedulesSuffixHeaders),
+		)
+		return
+	})
+
+	return errors.Wrap(err, "transaction failed")
+}
+
+// GetById retrieves schedule with given ID from the repo.
+func (repo *SchedulesRepository) GetById(ctx context.Context, id string) (record *models.Schedule, err error) {
+
+	records, err := repo.MGetById(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return records[0], nil
+}
+
+// MGetById retrieves schedules with given IDs from the repo.
+// Positions of the schedules that were not found are filled with nil.
+func (repo *SchedulesRepository) MGetById(ctx context.Context, ids []string) (records []*models.Schedule, err error) {
+
+	var (
+		dataCmds        []*redis.StringStringMapCmd
+		headersDataCmds []*redis.StringStringMapCmd
+	)
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		for _, id := range ids {
+			dataCmds = append(dataCmds, pipe.HGetAll(repo.buildKey(schedulesKeyData, id)))
+			headersDataCmds = append(headersDataCmds, pipe.HGetAll(repo.buildKey(schedulesKeyData, id, schedulesSuffixHeaders)))
+		}
+		return
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "pipeline failed")
+	}
+
+	for i, dataCmd := range dataCmds {
+		records = append(records, scheduleUnmarshal(dataCmd.Val(), headersDataCmds[i].Val()))
+	}
+
+	return
+}
+
+// Find returns a subset of the schedules, based on collection params given.
+func (repo *SchedulesRepository) Find(
+	ctx context.Context,
+	params *models.CollectionParams,
+) (records []*models.Schedule, info *models.CollectionInfo, err error) {
+
+	// Parse cursor
+	cursor, err := strconv.ParseUint(params.Cursor, 10, 64)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse cursor")
+	}
+
+	// Retrieve indexes
+	var (
+		idxCmd   *redis.ScanCmd
+		countCmd *redis.IntCmd
+	)
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		key := repo.buildKey(schedulesKeyIndexById)
+		idxCmd = pipe.ZScan(key, cursor, "*", int64(params.Limit))
+		countCmd = pipe.ZCard(key)
+		return
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to scan schedules index")
+	}
+
+	// ZSCAN returns member/score pairs, keep members only
+	keys, newCursor := idxCmd.Val()
+	var ids []string
+	for i := 0; i < len(keys); i += 2 {
+		ids = append(ids, keys[i])
+	}
+
+	// Retrieve records
+	records, err = repo.MGetById(ctx, ids)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to retrieve records")
+	}
+	info = &models.CollectionInfo{
+		Total:  uint64(countCmd.Val()),
+		Cursor: strconv.FormatUint(newCursor, 10),
+	}
+
+	return
+}
+
+// FindDue returns up to limit schedules whose next run time is not after given time.
+func (repo *SchedulesRepository) FindDue(ctx context.Context, now time.Time, limit int) (records []*models.Schedule, err error) {
+
+	// Retrieve index
+	ids, err := repo.redisClient.WithContext(ctx).ZRangeByScore(repo.buildKey(schedulesKeyIndexByNext), redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(timeToMs(now), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to range schedules index")
+	}
+
+	// Retrieve records
+	records, err = repo.MGetById(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve records")
+	}
+
+	return
+}
+
+// Advance atomically moves next run time of the schedule with given ID from the previous value to the next one.
+// Returns false if the schedule does not exist or its next run time is not the previous value anymore.
+func (repo *SchedulesRepository) Advance(
+	ctx context.Context,
+	id string,
+	prevRunAt time.Time,
+	nextRunAt time.Time,
+) (advanced bool, err error) {
+
+	result, err := schedulesScriptAdvance.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{
+			repo.buildKey(schedulesKeyData, id),
+			repo.buildKey(schedulesKeyIndexByNext),
+		},
+		id,
+		timeToMs(prevRunAt),
+		timeToMs(nextRunAt),
+		nextRunAt.Format(time.RFC3339Nano),
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "advance script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// buildKey is a helper function that builds a Redis key from key parts given.
+func (repo *SchedulesRepository) buildKey(parts ...string) (key string) {
+	return strings.Join(parts, ":")
+}
+
+// scheduleMarshal is a helper function that marshals record into format Redis understands.
+func scheduleMarshal(record *models.Schedule) (data, headersData map[string]interface{}) {
+
+	data = make(map[string]interface{})
+	headersData = make(map[string]interface{})
+
+	data["id"] = record.Id
+	data["queue_id"] = record.QueueId
+	data["expression"] = record.Expression
+	data["priority"] = strconv.Itoa(int(record.Priority))
+	data["input"] = record.Input
+	data["next_run_at"] = record.NextRunAt.Format(time.RFC3339Nano)
+	data["created_at"] = record.CreatedAt.Format(time.RFC3339Nano)
+
+	for key, value := range record.Headers {
+		headersData[key] = value
+	}
+
+	return
+}
+
+// scheduleUnmarshal is a helper function that unmarshals record from Redis format.
+// Returns nil if data is empty (record does not exist).
+func scheduleUnmarshal(data, headersData map[string]string) (record *models.Schedule) {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	priority, _ := strconv.Atoi(data["priority"])
+	nextRunAt, _ := time.Parse(time.RFC3339Nano, data["next_run_at"])
+	createdAt, _ := time.Parse(time.RFC3339Nano, data["created_at"])
+
+	record = &models.Schedule{
+		Id:         data["id"],
+		QueueId:    data["queue_id"],
+		Expression: data["expression"],
+		Priority:   uint8(priority),
+		Headers:    make(map[string]string),
+		Input:      []byte(data["input"]),
+		NextRunAt:  nextRunAt,
+		CreatedAt:  createdAt,
+	}
+	for key, value := range headersData {
+		record.Headers[key] = value
+	}
+
+	return
+}
@@ -0,0 +1,1780 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+)
+
+const (
+	tasksKeyData           string = "tasks"
+	tasksSuffixHeaders     string = "headers"
+	tasksSuffixOutput      string = "output"
+	tasksSuffixChildren    string = "children"
+	tasksSuffixIndex       string = "tasks"
+	tasksSuffixPending     string = "pending"
+	tasksSuffixProcessing  string = "processing"
+	tasksSuffixExpiring    string = "expiring"
+	tasksSuffixDelayed     string = "delayed"
+	tasksSuffixStats       string = "stats"
+	tasksSuffixSince       string = "since"
+	tasksSuffixRateLimit   string = "rate-limit"
+	tasksSuffixIdempotency string = "idempotency"
+	tasksSuffixUnique      string = "unique"
+	tasksStatsFieldExpired string = "expired"
+
+	tasksStatsFieldFinished     string = "finished"
+	tasksStatsFieldFailed       string = "failed"
+	tasksStatsFieldDeadLettered string = "dead_lettered"
+	tasksStatsFieldEnqueued     string = "enqueued"
+	tasksStatsFieldDequeued     string = "dequeued"
+	tasksStatsRateWindow        int64  = 60 // length of the window (s) the rates are measured over
+
+	tasksRedeliveryBatchSize int = 100 // max number of tasks redelivered by a single script call
+	tasksExpirationBatchSize int = 100 // max number of tasks checked for expiration by a single script call
+	tasksPromotionBatchSize  int = 100 // max number of delayed tasks promoted by a single script call
+	tasksPurgeBatchSize      int = 100 // max number of tasks checked for purging by a single script call
+	tasksDequeueMaxAttempts  int = 100 // max number of expired tasks skipped by a single dequeue script call
+	tasksStatsMaxCleanups    int = 100 // max number of stale pending time entries removed by a single stats call
+	tasksAttemptHistoryLimit int = 100 // max number of the failed attempts kept in the attempt history of the task
+)
+
+var (
+	// tasksLuaPushPending defines a Lua function that puts the task to the pending set of its queue.
+	// Score of the task depends on its priority and on the priority mode of the queue:
+	//   - strict mode puts priority into the high bits of the score, so priority always wins over time;
+	//   - weighted mode moves the task ahead by the priority weight (s) per priority level.
+	// Tasks with the same priority are delivered in the FIFO order in both modes.
+	// Scores are formatted explicitly, as Lua converts numbers to strings with 14 significant digits only.
+	// Time the task is put to the queue is tracked separately, so the age of the oldest pending task is known.
+	//
+	// settingsKey - queue settings key;
+	// pendingKey  - pending set of the queue;
+	// taskKey     - task data key;
+	// id          - task ID;
+	// now         - time the task is put to the queue (ms).
+	tasksLuaPushPending = fmt.Sprintf(`
+		local function pushPending(settingsKey, pendingKey, taskKey, id, now)
+			local settings = redis.call('HMGET', settingsKey, %q, %q)
+			local mode = settings[1] or %q
+			local weight = tonumber(settings[2]) or %s
+			local priority = tonumber(redis.call('HGET', taskKey, 'priority')) or 0
+			local score = (255 - priority) * %d + tonumber(now)
+			if mode == %q then
+				score = tonumber(now) - priority * weight * 1000
+			end
+			redis.call('ZADD', pendingKey, string.format('%%.0f', score), id)
+			redis.call('ZADD', pendingKey .. ':' .. %q, now, id)
+		end
+	`,
+		models.QueueSettingPriorityMode,
+		models.QueueSettingPriorityWeight,
+		models.DefaultQueueSettings()[models.QueueSettingPriorityMode],
+		models.DefaultQueueSettings()[models.QueueSettingPriorityWeight],
+		int64(1)<<45, // leaves 45 bits for the time (ms), which lasts until year 3084
+		models.QueuePriorityModeWeighted,
+		tasksSuffixSince,
+	)
+
+	// tasksLuaStats defines a Lua function that increments the counter of the queue along with its rate.
+	// Rate is tracked in a ring of per-second slots, a slot is reset once it is reused in the next window.
+	// Second of the slot is stored as integer text, so it is parsed the same way regardless of the Lua runtime.
+	//
+	// statsKey - queue stats key;
+	// counter  - name of the counter;
+	// now      - current time (ms).
+	tasksLuaStats = fmt.Sprintf(`
+		local function countRate(statsKey, counter, now)
+			local second = math.floor(tonumber(now) / 1000)
+			local slot = second %% %d
+			local slotSecond = string.format('%%d', second)
+			local rateKey = statsKey .. ':' .. counter
+			if redis.call('HGET', rateKey, 't' .. slot) ~= slotSecond then
+				redis.call('HMSET', rateKey, 't' .. slot, slotSecond, 'c' .. slot, 0)
+			end
+			redis.call('HINCRBY', rateKey, 'c' .. slot, 1)
+			redis.call('HINCRBY', statsKey, counter, 1)
+		end
+	`,
+		tasksStatsRateWindow,
+	)
+
+	// tasksLuaWorkflow defines Lua functions that settle the workflow children of the task.
+	// Children that are not waiting for their parents anymore are left intact.
+	//   - unlockChildren decrements the number of the pending parents of the waiting children
+	//     and puts those that have no pending parents left to the pending sets of their queues;
+	//   - cancelDescendants cancels the waiting children and, recursively, their own children.
+	// Requires tasksLuaPushPending.
+	//
+	// taskKey    - task data key;
+	// now        - current time (ms);
+	// finishedAt - finish time of the cancelled tasks.
+	tasksLuaWorkflow = fmt.Sprintf(`
+		local function unlockChildren(taskKey, now)
+			for _, id in ipairs(redis.call('SMEMBERS', taskKey .. ':' .. %[1]q)) do
+				local key = %[2]q .. ':' .. id
+				local data = redis.call('HMGET', key, 'queue_id', 'status')
+				if data[2] == '%[3]d' and redis.call('HINCRBY', key, 'pending_parents', -1) <= 0 then
+					local queueKey = %[4]q .. ':' .. data[1]
+					redis.call('HSET', key, 'status', '%[5]d')
+					pushPending(queueKey .. ':' .. %[6]q, queueKey .. ':' .. %[7]q, key, id, now)
+				end
+			end
+		end
+
+		local function cancelDescendants(taskKey, finishedAt)
+			local keys = {taskKey}
+			while #keys > 0 do
+				local parentKey = table.remove(keys)
+				for _, id in ipairs(redis.call('SMEMBERS', parentKey .. ':' .. %[1]q)) do
+					local key = %[2]q .. ':' .. id
+					if redis.call('HGET', key, 'status') == '%[3]d' then
+						redis.call('HMSET', key, 'status', '%[8]d', 'finished_at', finishedAt)
+						table.insert(keys, key)
+					end
+				end
+			end
+		end
+	`,
+		tasksSuffixChildren,
+		tasksKeyData,
+		models.TaskStatusWaiting,
+		queuesKeyData,
+		models.TaskStatusPending,
+		queuesSuffixSettings,
+		tasksSuffixIndex+":"+tasksSuffixPending,
+		models.TaskStatusCancelled,
+	)
+
+	// tasksLuaBatch defines a Lua function that counts the settled task of its batch, once per task.
+	// Once all tasks of the batch are settled, the summary of the batch is set as the input of the waiting
+	// callback task and the callback task is put to the pending set of its queue, unless it is cancelled.
+	// Summary is a JSON object with `batch_id`, `total`, `succeeded` and `failed` fields and, if the batch
+	// includes results, `results` field with the task ID => output pairs of the tasks that have output.
+	// Requires tasksLuaPushPending.
+	//
+	// taskKey    - task data key;
+	// id         - task ID;
+	// succeeded  - whether the task is finished successfully;
+	// now        - current time (ms);
+	// finishedAt - current time (finish time of the batch).
+	tasksLuaBatch = fmt.Sprintf(`
+		local function settleBatch(taskKey, id, succeeded, now, finishedAt)
+			local batchId = redis.call('HGET', taskKey, 'batch_id')
+			if not batchId or batchId == '' then
+				return
+			end
+			local batchKey = %[1]q .. ':' .. batchId
+			if redis.call('EXISTS', batchKey) == 0 or redis.call('SADD', batchKey .. ':' .. %[2]q, id) == 0 then
+				return
+			end
+			redis.call('HINCRBY', batchKey, succeeded and 'succeeded' or 'failed', 1)
+			local batch = redis.call('HMGET', batchKey, 'total', 'succeeded', 'failed', 'callback_task_id', 'include_results')
+			local summary = {
+				batch_id = batchId,
+				total = tonumber(batch[1]),
+				succeeded = tonumber(batch[2]),
+				failed = tonumber(batch[3]),
+			}
+			if summary.succeeded + summary.failed < summary.total then
+				return
+			end
+			redis.call('HSET', batchKey, 'finished_at', finishedAt)
+			if batch[5] == '1' then
+				summary.results = {}
+				for _, taskId in ipairs(redis.call('LRANGE', batchKey .. ':' .. %[3]q, 0, -1)) do
+					local output = redis.call('GET', %[4]q .. ':' .. taskId .. ':' .. %[5]q)
+					if output then
+						summary.results[taskId] = output
+					end
+				end
+			end
+			local callbackKey = %[4]q .. ':' .. batch[4]
+			local callback = redis.call('HMGET', callbackKey, 'queue_id', 'status')
+			if callback[2] ~= '%[6]d' then
+				return
+			end
+			local queueKey = %[7]q .. ':' .. callback[1]
+			redis.call('HMSET', callbackKey, 'input', cjson.encode(summary), 'status', '%[8]d')
+			pushPending(queueKey .. ':' .. %[9]q, queueKey .. ':' .. %[10]q, callbackKey, batch[4], now)
+		end
+	`,
+		batchesKeyData,
+		batchesSuffixSettled,
+		batchesSuffixTasks,
+		tasksKeyData,
+		tasksSuffixOutput,
+		models.TaskStatusWaiting,
+		queuesKeyData,
+		models.TaskStatusPending,
+		queuesSuffixSettings,
+		tasksSuffixIndex+":"+tasksSuffixPending,
+	)
+
+	// tasksLuaUnique defines a Lua function that releases the uniqueness key of the task, if the task holds it.
+	// Must be called before the task is moved to another queue.
+	//
+	// taskKey - task data key;
+	// id      - task ID.
+	tasksLuaUnique = fmt.Sprintf(`
+		local function releaseUnique(taskKey, id)
+			local data = redis.call('HMGET', taskKey, 'queue_id', 'unique_key')
+			if not data[2] or data[2] == '' then
+				return
+			end
+			local lockKey = %q .. ':' .. data[1] .. ':' .. %q .. ':' .. data[2]
+			if redis.call('GET', lockKey) == id then
+				redis.call('DEL', lockKey)
+			end
+		end
+	`,
+		queuesKeyData,
+		tasksSuffixUnique,
+	)
+
+	// tasksLuaAttempts defines a Lua function that records a failed processing attempt of the task:
+	// increments its attempts counter, sets its last error and appends the error to its attempt history.
+	// History is kept in the task headers as a JSON list of the errors of the most recent attempts, the oldest first.
+	// It survives dead-lettering, so it covers the attempts made in all queues the task went through.
+	//
+	// taskKey   - task data key;
+	// lastError - reason of the failure.
+	tasksLuaAttempts = fmt.Sprintf(`
+		local function recordAttempt(taskKey, lastError)
+			redis.call('HINCRBY', taskKey, 'attempts', 1)
+			redis.call('HSET', taskKey, 'last_error', lastError)
+			local headersKey = taskKey .. ':' .. %[1]q
+			local ok, history = pcall(cjson.decode, redis.call('HGET', headersKey, %[2]q) or '[]')
+			if not ok or type(history) ~= 'table' then
+				history = {}
+			end
+			table.insert(history, lastError)
+			while #history > %[3]d do
+				table.remove(history, 1)
+			end
+			redis.call('HSET', headersKey, %[2]q, cjson.encode(history))
+		end
+	`,
+		tasksSuffixHeaders,
+		models.TaskHeaderAttemptErrors,
+		tasksAttemptHistoryLimit,
+	)
+
+	// tasksScriptPushPending puts the task to the pending set of its queue.
+	//
+	// KEYS[1] - queue settings key;
+	// KEYS[2] - pending set of the queue;
+	// KEYS[3] - task data key;
+	// ARGV[1] - task ID;
+	// ARGV[2] - current time (ms).
+	tasksScriptPushPending = redis.NewScript(tasksLuaPushPending + `
+		pushPending(KEYS[1], KEYS[2], KEYS[3], ARGV[1], ARGV[2])
+		return 1
+	`)
+
+	// tasksScriptCountRate increments the counter of the queue along with its rate.
+	//
+	// KEYS[1] - queue stats key;
+	// ARGV[1] - name of the counter;
+	// ARGV[2] - current time (ms).
+	tasksScriptCountRate = redis.NewScript(tasksLuaStats + `
+		countRate(KEYS[1], ARGV[1], ARGV[2])
+		return 1
+	`)
+
+	// tasksScriptOldestPending returns the time (ms) the oldest pending task of the queue was put to the queue.
+	// Entries of the tasks that are not pending anymore are removed on the way.
+	//
+	// KEYS[1] - pending set of the queue;
+	// KEYS[2] - pending time set of the queue;
+	// ARGV[1] - max number of the stale entries to remove.
+	tasksScriptOldestPending = redis.NewScript(`
+		for i = 1, tonumber(ARGV[1]) do
+			local entry = redis.call('ZRANGE', KEYS[2], 0, 0, 'WITHSCORES')
+			if #entry == 0 then
+				return false
+			end
+			if redis.call('ZSCORE', KEYS[1], entry[1]) then
+				return entry[2]
+			end
+			redis.call('ZREM', KEYS[2], entry[1])
+		end
+		return false
+	`)
+
+	// tasksScriptDequeue pops the first task ID from the pending set, marks that task as processing
+	// and leases it to the job until the visibility timeout of the queue passes.
+	// Nothing is leased while the queue is paused. Leased tasks are counted by the dequeue counter of the queue.
+	// Tasks that are already expired are marked as such, release their uniqueness keys and are skipped.
+	// If rate limit of the queue is enabled, every leased task takes a token from the queue token bucket,
+	// which holds up to the rate limit tokens and is refilled at the rate of tokens per rate limit duration.
+	// Nothing is leased while the bucket is empty.
+	//
+	// KEYS[1] - pending set of the queue;
+	// KEYS[2] - processing set of the queue;
+	// KEYS[3] - queue settings key;
+	// KEYS[4] - expiring set of the queue;
+	// KEYS[5] - queue stats key;
+	// KEYS[6] - token bucket of the queue;
+	// KEYS[7] - queue data key;
+	// ARGV[1] - prefix of the task data keys;
+	// ARGV[2] - processing status;
+	// ARGV[3] - job ID;
+	// ARGV[4] - current time (ms);
+	// ARGV[5] - name of the visibility timeout setting;
+	// ARGV[6] - default visibility timeout (s);
+	// ARGV[7] - expired status;
+	// ARGV[8] - current time (finish time of the expired tasks);
+	// ARGV[9] - max number of expired tasks to skip;
+	// ARGV[10] - name of the rate limit enabled setting;
+	// ARGV[11] - name of the rate limit tokens setting;
+	// ARGV[12] - name of the rate limit duration setting.
+	tasksScriptDequeue = redis.NewScript(tasksLuaUnique + tasksLuaStats + `
+		if redis.call('HGET', KEYS[7], 'paused') == '1' then
+			return false
+		end
+		local now = tonumber(ARGV[4])
+
+		-- Refill token bucket
+		local limit = redis.call('HMGET', KEYS[3], ARGV[10], ARGV[11], ARGV[12])
+		local capacity = tonumber(limit[2]) or 0
+		local duration = (tonumber(limit[3]) or 0) * 1000
+		local limited = limit[1] == '1' and duration > 0
+		local tokens = 0
+		if limited then
+			local bucket = redis.call('HMGET', KEYS[6], 'tokens', 'updated_at')
+			tokens = tonumber(bucket[1]) or capacity
+			local elapsed = math.max(0, now - (tonumber(bucket[2]) or now))
+			tokens = math.min(capacity, tokens + elapsed * capacity / duration)
+			if tokens < 1 then
+				redis.call('HMSET', KEYS[6], 'tokens', tokens, 'updated_at', now)
+				return false
+			end
+		end
+
+		for i = 1, tonumber(ARGV[9]) do
+			local ids = redis.call('ZRANGE', KEYS[1], 0, 0)
+			if #ids == 0 then
+				return false
+			end
+			local id = ids[1]
+			local key = ARGV[1] .. ':' .. id
+			redis.call('ZREM', KEYS[1], id)
+			redis.call('ZREM', KEYS[1] .. ':since', id)
+			local expiresAt = tonumber(redis.call('ZSCORE', KEYS[4], id))
+			if expiresAt and expiresAt <= now then
+				redis.call('ZREM', KEYS[4], id)
+				redis.call('HMSET', key, 'status', ARGV[7], 'finished_at', ARGV[8])
+				redis.call('HINCRBY', KEYS[5], 'expired', 1)
+				releaseUnique(key, id)
+			else
+				local timeout = tonumber(redis.call('HGET', KEYS[3], ARGV[5])) or tonumber(ARGV[6])
+				redis.call('ZADD', KEYS[2], now + timeout * 1000, id)
+				redis.call('HMSET', key, 'status', ARGV[2], 'job_id', ARGV[3])
+				if limited then
+					redis.call('HMSET', KEYS[6], 'tokens', tokens - 1, 'updated_at', now)
+				end
+				countRate(KEYS[5], 'dequeued', now)
+				return id
+			end
+		end
+		return false
+	`)
+
+	// tasksScriptUpdateStatus changes status of the task and keeps the pending and processing sets of its queue in sync.
+	//
+	// KEYS[1] - task data key;
+	// ARGV[1] - task ID;
+	// ARGV[2] - prefix of the queue keys;
+	// ARGV[3] - new status;
+	// ARGV[4] - pending status;
+	// ARGV[5] - current time (ms).
+	tasksScriptUpdateStatus = redis.NewScript(tasksLuaPushPending + `
+		local queueId = redis.call('HGET', KEYS[1], 'queue_id')
+		if not queueId then
+			return redis.error_reply('task not found')
+		end
+		local queueKey = ARGV[2] .. ':' .. queueId .. ':tasks'
+		redis.call('HSET', KEYS[1], 'status', ARGV[3])
+		redis.call('ZREM', queueKey .. ':processing', ARGV[1])
+		redis.call('ZREM', queueKey .. ':delayed', ARGV[1])
+		if ARGV[3] == ARGV[4] then
+			pushPending(ARGV[2] .. ':' .. queueId .. ':settings', queueKey .. ':pending', KEYS[1], ARGV[1], ARGV[5])
+		else
+			redis.call('ZREM', queueKey .. ':pending', ARGV[1])
+			redis.call('ZREM', queueKey .. ':pending:since', ARGV[1])
+		end
+		return true
+	`)
+
+	// tasksScriptFinish sets final status and finish time of the task, if the job still holds the task lease.
+	// Non-empty output is stored until the result TTL of the queue passes, zero TTL keeps it forever.
+	// Successfully finished task unlocks its workflow children. Task is counted as settled by its batch
+	// and releases its uniqueness key.
+	//
+	// KEYS[1] - task data key;
+	// KEYS[2] - task output key;
+	// ARGV[1] - task ID;
+	// ARGV[2] - prefix of the queue keys;
+	// ARGV[3] - processing status;
+	// ARGV[4] - job ID;
+	// ARGV[5] - final status;
+	// ARGV[6] - finish time;
+	// ARGV[7] - output;
+	// ARGV[8] - name of the result TTL setting;
+	// ARGV[9] - default result TTL (s);
+	// ARGV[10] - finished status;
+	// ARGV[11] - current time (ms).
+	tasksScriptFinish = redis.NewScript(tasksLuaPushPending + tasksLuaWorkflow + tasksLuaBatch + tasksLuaUnique + `
+		local data = redis.call('HMGET', KEYS[1], 'queue_id', 'status', 'job_id')
+		if not data[1] or data[2] ~= ARGV[3] or data[3] ~= ARGV[4] then
+			return 0
+		end
+		local queueKey = ARGV[2] .. ':' .. data[1] .. ':tasks'
+		redis.call('ZREM', queueKey .. ':processing', ARGV[1])
+		redis.call('ZREM', queueKey .. ':expiring', ARGV[1])
+		redis.call('HMSET', KEYS[1], 'status', ARGV[5], 'finished_at', ARGV[6])
+		if ARGV[7] ~= '' then
+			local ttl = tonumber(redis.call('HGET', ARGV[2] .. ':' .. data[1] .. ':settings', ARGV[8])) or tonumber(ARGV[9])
+			if ttl > 0 then
+				redis.call('SET', KEYS[2], ARGV[7], 'EX', ttl)
+			else
+				redis.call('SET', KEYS[2], ARGV[7])
+			end
+		end
+		if ARGV[5] == ARGV[10] then
+			redis.call('HINCRBY', ARGV[2] .. ':' .. data[1] .. ':stats', 'finished', 1)
+			unlockChildren(KEYS[1], ARGV[11])
+		end
+		settleBatch(KEYS[1], ARGV[1], ARGV[5] == ARGV[10], ARGV[11], ARGV[6])
+		releaseUnique(KEYS[1], ARGV[1])
+		return 1
+	`)
+
+	// tasksScriptRequeue puts the task back to the pending set, if the job still holds the task lease.
+	//
+	// KEYS[1] - task data key;
+	// ARGV[1] - task ID;
+	// ARGV[2] - prefix of the queue keys;
+	// ARGV[3] - processing status;
+	// ARGV[4] - job ID;
+	// ARGV[5] - pending status;
+	// ARGV[6] - current time (ms).
+	tasksScriptRequeue = redis.NewScript(tasksLuaPushPending + `
+		local data = redis.call('HMGET', KEYS[1], 'queue_id', 'status', 'job_id')
+		if not data[1] or data[2] ~= ARGV[3] or data[3] ~= ARGV[4] then
+			return 0
+		end
+		local queueKey = ARGV[2] .. ':' .. data[1] .. ':tasks'
+		redis.call('ZREM', queueKey .. ':processing', ARGV[1])
+		pushPending(ARGV[2] .. ':' .. data[1] .. ':settings', queueKey .. ':pending', KEYS[1], ARGV[1], ARGV[6])
+		redis.call('HSET', KEYS[1], 'status', ARGV[5])
+		return 1
+	`)
+
+	// tasksScriptTouch extends the task lease until the visibility timeout of the queue passes again,
+	// if the job still holds the task lease.
+	//
+	// KEYS[1] - task data key;
+	// ARGV[1] - task ID;
+	// ARGV[2] - prefix of the queue keys;
+	// ARGV[3] - processing status;
+	// ARGV[4] - job ID;
+	// ARGV[5] - current time (ms);
+	// ARGV[6] - name of the visibility timeout setting;
+	// ARGV[7] - default visibility timeout (s).
+	tasksScriptTouch = redis.NewScript(`
+		local data = redis.call('HMGET', KEYS[1], 'queue_id', 'status', 'job_id')
+		if not data[1] or data[2] ~= ARGV[3] or data[3] ~= ARGV[4] then
+			return 0
+		end
+		local timeout = tonumber(redis.call('HGET', ARGV[2] .. ':' .. data[1] .. ':settings', ARGV[6])) or tonumber(ARGV[7])
+		redis.call('ZADD', ARGV[2] .. ':' .. data[1] .. ':tasks:processing', tonumber(ARGV[5]) + timeout * 1000, ARGV[1])
+		return 1
+	`)
+
+	// tasksScriptRetry records a failed attempt of the task and puts the task back to the pending set,
+	// or to the delayed set if it should be retried later, if the job still holds the task lease.
+	//
+	// KEYS[1] - task data key;
+	// ARGV[1] - task ID;
+	// ARGV[2] - prefix of the queue keys;
+	// ARGV[3] - processing status;
+	// ARGV[4] - job ID;
+	// ARGV[5] - pending status;
+	// ARGV[6] - delayed status;
+	// ARGV[7] - current time (ms);
+	// ARGV[8] - run time of the retry (ms);
+	// ARGV[9] - run time of the retry;
+	// ARGV[10] - reason of the failure.
+	tasksScriptRetry = redis.NewScript(tasksLuaPushPending + tasksLuaAttempts + `
+		local data = redis.call('HMGET', KEYS[1], 'queue_id', 'status', 'job_id')
+		if not data[1] or data[2] ~= ARGV[3] or data[3] ~= ARGV[4] then
+			return 0
+		end
+		local queueKey = ARGV[2] .. ':' .. data[1] .. ':tasks'
+		redis.call('ZREM', queueKey .. ':processing', ARGV[1])
+		recordAttempt(KEYS[1], ARGV[10])
+		if tonumber(ARGV[8]) > tonumber(ARGV[7]) then
+			redis.call('ZADD', queueKey .. ':delayed', ARGV[8], ARGV[1])
+			redis.call('HMSET', KEYS[1], 'status', ARGV[6], 'run_at', ARGV[9])
+		else
+			pushPending(ARGV[2] .. ':' .. data[1] .. ':settings', queueKey .. ':pending', KEYS[1], ARGV[1], ARGV[7])
+			redis.call('HSET', KEYS[1], 'status', ARGV[5])
+		end
+		return 1
+	`)
+
+	// tasksScriptFail records a failed attempt of the task and marks the task as failed,
+	// if the job still holds the task lease. Workflow tasks that depend on the task are cancelled.
+	// Task is counted as settled by its batch and releases its uniqueness key.
+	//
+	// KEYS[1] - task data key;
+	// ARGV[1] - task ID;
+	// ARGV[2] - prefix of the queue keys;
+	// ARGV[3] - processing status;
+	// ARGV[4] - job ID;
+	// ARGV[5] - failed status;
+	// ARGV[6] - finish time;
+	// ARGV[7] - reason of the failure;
+	// ARGV[8] - current time (ms).
+	tasksScriptFail = redis.NewScript(
+		tasksLuaPushPending + tasksLuaWorkflow + tasksLuaBatch + tasksLuaUnique + tasksLuaAttempts + `
+		local data = redis.call('HMGET', KEYS[1], 'queue_id', 'status', 'job_id')
+		if not data[1] or data[2] ~= ARGV[3] or data[3] ~= ARGV[4] then
+			return 0
+		end
+		local queueKey = ARGV[2] .. ':' .. data[1] .. ':tasks'
+		redis.call('ZREM', queueKey .. ':processing', ARGV[1])
+		redis.call('ZREM', queueKey .. ':expiring', ARGV[1])
+		recordAttempt(KEYS[1], ARGV[7])
+		redis.call('HMSET', KEYS[1], 'status', ARGV[5], 'finished_at', ARGV[6])
+		redis.call('HINCRBY', ARGV[2] .. ':' .. data[1] .. ':stats', 'failed', 1)
+		cancelDescendants(KEYS[1], ARGV[6])
+		settleBatch(KEYS[1], ARGV[1], false, ARGV[8], ARGV[6])
+		releaseUnique(KEYS[1], ARGV[1])
+		return 1
+	`)
+
+	// tasksScriptDeadLetter moves the task into the pending set of the dead-letter queue and adds headers to it,
+	// if the job still holds the task lease. Workflow tasks that depend on the task are cancelled.
+	// Task is counted as settled (failed) by its batch, even if it is finished in the dead-letter queue later.
+	// Uniqueness key of the task is released, the key is not held in the dead-letter queue.
+	//
+	// KEYS[1] - task data key;
+	// KEYS[2] - task headers key;
+	// KEYS[3] - dead-letter queue settings key;
+	// KEYS[4] - pending set of the dead-letter queue;
+	// KEYS[5] - tasks index of the dead-letter queue;
+	// ARGV[1] - task ID;
+	// ARGV[2] - prefix of the queue keys;
+	// ARGV[3] - processing status;
+	// ARGV[4] - job ID;
+	// ARGV[5] - pending status;
+	// ARGV[6] - current time (ms);
+	// ARGV[7] - reason of the failure;
+	// ARGV[8] - dead-letter queue ID;
+	// ARGV[9] - zero time;
+	// ARGV[10] - current time (finish time of the cancelled workflow tasks);
+	// ARGV[11...] - header key/value pairs.
+	tasksScriptDeadLetter = redis.NewScript(
+		tasksLuaPushPending + tasksLuaWorkflow + tasksLuaBatch + tasksLuaUnique + tasksLuaStats + tasksLuaAttempts + `
+		local data = redis.call('HMGET', KEYS[1], 'queue_id', 'status', 'job_id')
+		if not data[1] or data[2] ~= ARGV[3] or data[3] ~= ARGV[4] then
+			return 0
+		end
+		recordAttempt(KEYS[1], ARGV[7])
+		local queueKey = ARGV[2] .. ':' .. data[1] .. ':tasks'
+		redis.call('ZREM', queueKey, ARGV[1])
+		redis.call('ZREM', queueKey .. ':processing', ARGV[1])
+		redis.call('ZREM', queueKey .. ':expiring', ARGV[1])
+		redis.call('HINCRBY', ARGV[2] .. ':' .. data[1] .. ':stats', 'dead_lettered', 1)
+		releaseUnique(KEYS[1], ARGV[1])
+		redis.call('HMSET', KEYS[1],
+			'queue_id', ARGV[8], 'status', ARGV[5], 'job_id', '', 'attempts', 0, 'last_error', ARGV[7],
+			'expires_at', ARGV[9], 'run_at', ARGV[9], 'unique_key', '')
+		if #ARGV > 10 then
+			redis.call('HMSET', KEYS[2], unpack(ARGV, 11))
+		end
+		redis.call('ZADD', KEYS[5], ARGV[6], ARGV[1])
+		pushPending(KEYS[3], KEYS[4], KEYS[1], ARGV[1], ARGV[6])
+		countRate(ARGV[2] .. ':' .. ARGV[8] .. ':stats', 'enqueued', ARGV[6])
+		cancelDescendants(KEYS[1], ARGV[10])
+		settleBatch(KEYS[1], ARGV[1], false, ARGV[6], ARGV[10])
+		return 1
+	`)
+
+	// tasksScriptRedeliver puts the tasks with expired leases back to the pending set.
+	//
+	// KEYS[1] - processing set of the queue;
+	// KEYS[2] - pending set of the queue;
+	// KEYS[3] - queue settings key;
+	// ARGV[1] - prefix of the task data keys;
+	// ARGV[2] - current time (ms);
+	// ARGV[3] - pending status;
+	// ARGV[4] - max number of tasks to redeliver.
+	tasksScriptRedeliver = redis.NewScript(tasksLuaPushPending + `
+		local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, ARGV[4])
+		for _, id in ipairs(ids) do
+			local key = ARGV[1] .. ':' .. id
+			redis.call('ZREM', KEYS[1], id)
+			pushPending(KEYS[3], KEYS[2], key, id, ARGV[2])
+			redis.call('HSET', key, 'status', ARGV[3])
+			redis.call('HINCRBY', key, 'redeliveries', 1)
+		end
+		return #ids
+	`)
+
+	// tasksScriptCancel marks the task as cancelled, if it is still pending, delayed or waiting.
+	// Workflow tasks that depend on the task are cancelled as well. Task is counted as settled by its batch
+	// and releases its uniqueness key.
+	//
+	// KEYS[1] - task data key;
+	// ARGV[1] - task ID;
+	// ARGV[2] - prefix of the queue keys;
+	// ARGV[3] - pending status;
+	// ARGV[4] - cancelled status;
+	// ARGV[5] - finish time;
+	// ARGV[6] - delayed status;
+	// ARGV[7] - waiting status;
+	// ARGV[8] - current time (ms).
+	tasksScriptCancel = redis.NewScript(tasksLuaPushPending + tasksLuaWorkflow + tasksLuaBatch + tasksLuaUnique + `
+		local data = redis.call('HMGET', KEYS[1], 'queue_id', 'status')
+		if not data[1] or (data[2] ~= ARGV[3] and data[2] ~= ARGV[6] and data[2] ~= ARGV[7]) then
+			return 0
+		end
+		local queueKey = ARGV[2] .. ':' .. data[1] .. ':tasks'
+		redis.call('ZREM', queueKey .. ':pending', ARGV[1])
+		redis.call('ZREM', queueKey .. ':pending:since', ARGV[1])
+		redis.call('ZREM', queueKey .. ':delayed', ARGV[1])
+		redis.call('ZREM', queueKey .. ':expiring', ARGV[1])
+		redis.call('HMSET', KEYS[1], 'status', ARGV[4], 'finished_at', ARGV[5])
+		cancelDescendants(KEYS[1], ARGV[5])
+		settleBatch(KEYS[1], ARGV[1], false, ARGV[8], ARGV[5])
+		releaseUnique(KEYS[1], ARGV[1])
+		return 1
+	`)
+
+	// tasksScriptExpire marks pending and delayed tasks with passed expiration time as expired.
+	// Tasks that are processing at the moment are kept in the expiring set, so they are expired once they
+	// are put back to the pending set. Tasks with any other status are just removed from the expiring set.
+	// Expired tasks release their uniqueness keys.
+	//
+	// KEYS[1] - expiring set of the queue;
+	// KEYS[2] - pending set of the queue;
+	// KEYS[3] - queue stats key;
+	// KEYS[4] - delayed set of the queue;
+	// ARGV[1] - prefix of the task data keys;
+	// ARGV[2] - current time (ms);
+	// ARGV[3] - pending status;
+	// ARGV[4] - processing status;
+	// ARGV[5] - expired status;
+	// ARGV[6] - current time (finish time of the expired tasks);
+	// ARGV[7] - number of the expiring set entries to skip;
+	// ARGV[8] - max number of the expiring set entries to check;
+	// ARGV[9] - delayed status.
+	tasksScriptExpire = redis.NewScript(tasksLuaUnique + `
+		local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[2], 'LIMIT', ARGV[7], ARGV[8])
+		local expired, kept = 0, 0
+		for _, id in ipairs(ids) do
+			local key = ARGV[1] .. ':' .. id
+			local status = redis.call('HGET', key, 'status')
+			if status == ARGV[4] then
+				kept = kept + 1
+			else
+				redis.call('ZREM', KEYS[1], id)
+				if status == ARGV[3] or status == ARGV[9] then
+					redis.call('ZREM', KEYS[2], id)
+					redis.call('ZREM', KEYS[2] .. ':since', id)
+					redis.call('ZREM', KEYS[4], id)
+					redis.call('HMSET', key, 'status', ARGV[5], 'finished_at', ARGV[6])
+					redis.call('HINCRBY', KEYS[3], 'expired', 1)
+					releaseUnique(key, id)
+					expired = expired + 1
+				end
+			end
+		end
+		return {expired, kept, #ids}
+	`)
+
+	// tasksScriptPromote moves the delayed tasks whose run time has come to the pending set.
+	// Run time of the task is used as the time it is put to the queue, so promoted tasks keep their order.
+	//
+	// KEYS[1] - delayed set of the queue;
+	// KEYS[2] - pending set of the queue;
+	// KEYS[3] - queue settings key;
+	// ARGV[1] - prefix of the task data keys;
+	// ARGV[2] - current time (ms);
+	// ARGV[3] - pending status;
+	// ARGV[4] - max number of tasks to promote.
+	tasksScriptPromote = redis.NewScript(tasksLuaPushPending + `
+		local entries = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[2], 'WITHSCORES', 'LIMIT', 0, ARGV[4])
+		for i = 1, #entries, 2 do
+			local id = entries[i]
+			local key = ARGV[1] .. ':' .. id
+			redis.call('ZREM', KEYS[1], id)
+			pushPending(KEYS[3], KEYS[2], key, id, entries[i + 1])
+			redis.call('HSET', key, 'status', ARGV[3])
+		end
+		return #entries / 2
+	`)
+
+	// tasksScriptPurge deletes the pending or delayed tasks of the queue, optionally only those dead-lettered
+	// from the queue with given name. Tasks are settled the same way cancelled tasks are before deletion:
+	// their workflow descendants are cancelled, their batches count them as failed and their uniqueness keys
+	// are released. Tasks with any other status are never touched, so the leases of the jobs stay intact.
+	//
+	// KEYS[1] - pending or delayed set of the queue;
+	// KEYS[2] - tasks index of the queue;
+	// KEYS[3] - expiring set of the queue;
+	// ARGV[1] - prefix of the task data keys;
+	// ARGV[2] - status of the tasks in the set;
+	// ARGV[3] - name of the queue the tasks were dead-lettered from, empty to purge all tasks;
+	// ARGV[4] - number of the set entries to skip;
+	// ARGV[5] - max number of the set entries to check;
+	// ARGV[6] - current time (ms);
+	// ARGV[7] - current time (finish time of the cancelled workflow tasks);
+	// ARGV[8] - name of the original queue header.
+	tasksScriptPurge = redis.NewScript(tasksLuaPushPending + tasksLuaWorkflow + tasksLuaBatch + tasksLuaUnique + `
+		local ids = redis.call('ZRANGE', KEYS[1], ARGV[4], tonumber(ARGV[4]) + tonumber(ARGV[5]) - 1)
+		local purged, kept = 0, 0
+		for _, id in ipairs(ids) do
+			local key = ARGV[1] .. ':' .. id
+			local matched = redis.call('HGET', key, 'status') == ARGV[2]
+			if matched and ARGV[3] ~= '' then
+				matched = redis.call('HGET', key .. ':headers', ARGV[8]) == ARGV[3]
+			end
+			if matched then
+				cancelDescendants(key, ARGV[7])
+				settleBatch(key, id, false, ARGV[6], ARGV[7])
+				releaseUnique(key, id)
+				redis.call('ZREM', KEYS[1], id)
+				redis.call('ZREM', KEYS[1] .. ':since', id)
+				redis.call('ZREM', KEYS[2], id)
+				redis.call('ZREM', KEYS[3], id)
+				redis.call('DEL', key, key .. ':headers', key .. ':output', key .. ':children')
+				purged = purged + 1
+			else
+				kept = kept + 1
+			end
+		end
+		return {purged, kept, #ids}
+	`)
+)
+
+// NewTasksRepository creates a new instance of TasksRepository.
+func NewTasksRepository(redisClient *redis.Client) (repo *TasksRepository) {
+	return &TasksRepository{
+		redisClient: redisClient,
+	}
+}
+
+// TasksRepository implements a Redis-based tasks repository.
+//
+// Most of the scripts of the repository build keys of the tasks and queues they touch from the task data
+// at run time instead of receiving them as KEYS, so the repository supports a single Redis instance only
+// (optionally with replicas) and can not be used with Redis Cluster.
+//
+// Redis schema:
+//   - HASH: `tasks:<task ID>`.
+//     Generic task information.
+//     Fields:
+//       - `id`;
+//       - `queue_id`;
+//       - `status`;
+//       - `priority`;
+//       - `input`;
+//       - `created_at`;
+//       - `expires_at`;
+//       - `run_at`;
+//       - `finished_at`;
+//       - `job_id` (ID of the job holding the task lease);
+//       - `redeliveries`;
+//       - `attempts`;
+//       - `last_error`;
+//       - `workflow_id`;
+//       - `pending_parents` (number of the workflow parents that are not finished yet);
+//       - `batch_id`;
+//       - `unique_key` (uniqueness key, if the task holds one).
+//   - HASH: `tasks:<task ID>:headers`.
+//     Task headers data.
+//   - STRING: `tasks:<task ID>:output`.
+//     Result data of the finished task, expires once the result TTL of the queue passes.
+//   - SET: `tasks:<task ID>:children`.
+//     IDs of the workflow tasks that depend on the task.
+//   - SORTED SET: `queues:<queue ID>:tasks`.
+//     An index containing IDs of all tasks of the queue and creation timestamp (ms) as a score.
+//   - SORTED SET: `queues:<queue ID>:tasks:pending`.
+//     IDs of the tasks that are waiting to be delivered. Tasks with the lowest score are delivered first.
+//     Score is based on the task priority and the time task was put to the set, see tasksLuaPushPending.
+//   - SORTED SET: `queues:<queue ID>:tasks:pending:since`.
+//     IDs of the pending tasks and the time (ms) they were put to the pending set as a score.
+//     Entries of the tasks that left the pending set may be kept until the stats of the queue are read.
+//   - SORTED SET: `queues:<queue ID>:tasks:processing`.
+//     IDs of the tasks that are leased to the jobs and lease expiration timestamp (ms) as a score.
+//   - SORTED SET: `queues:<queue ID>:tasks:delayed`.
+//     IDs of the delayed tasks and the time (ms) they become eligible for delivery as a score.
+//   - SORTED SET: `queues:<queue ID>:tasks:expiring`.
+//     IDs of the unfinished tasks that have expiration time and expiration timestamp (ms) as a score.
+//   - HASH: `queues:<queue ID>:rate-limit`.
+//     Token bucket of the queue, shared by all server nodes.
+//     Fields:
+//       - `tokens` (number of available tokens, may be fractional);
+//       - `updated_at` (time of the last bucket update, ms).
+//   - STRING: `queues:<queue ID>:idempotency:<idempotency key>`.
+//     ID of the task that was enqueued with the idempotency key, expires once the idempotency window passes.
+//   - STRING: `queues:<queue ID>:unique:<uniqueness key>`.
+//     ID of the task that holds the uniqueness key, deleted once the task is settled.
+//   - HASH: `queues:<queue ID>:stats`.
+//     Queue counters.
+//     Fields:
+//       - `expired` (number of tasks that expired before delivery);
+//       - `dead_lettered` (number of tasks that were moved to the dead-letter queue);
+//       - `finished` (number of tasks that were finished successfully);
+//       - `failed` (number of tasks that failed permanently);
+//       - `enqueued` (number of tasks that were put to the queue, including the dead-lettered ones);
+//       - `dequeued` (number of tasks that were leased to the jobs).
+//   - HASH: `queues:<queue ID>:stats:<enqueued|dequeued>`.
+//     Ring of per-second slots of the counter, used to measure its rate.
+//     Fields:
+//       - `t<slot>` (second the slot belongs to);
+//       - `c<slot>` (number of the counted tasks within the second).
+type TasksRepository struct {
+	redisClient *redis.Client // redis client instance
+}
+
+// Enqueue persists given task instance to the repo and puts it into the pending list of its queue,
+// or into the delayed list if the task is delayed.
+// Returns false if the task with the same ID already exists, so tasks with deterministic IDs are enqueued once.
+func (repo *TasksRepository) Enqueue(ctx context.Context, record *models.Task) (enqueued bool, err error) {
+
+	key := repo.buildKey(tasksKeyData, record.Id)
+	err = repo.redisClient.WithContext(ctx).Watch(func(tx *redis.Tx) (err error) {
+
+		// Skip existing task
+		exists, err := tx.Exists(key).Result()
+		if err != nil {
+			return errors.Wrap(err, "failed to check task existence")
+		}
+		if exists > 0 {
+			return nil
+		}
+
+		// Save task, transaction fails if the same task is saved concurrently
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) (err error) {
+			repo.save(pipe, record)
+			return
+		})
+		if err == redis.TxFailedErr {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "transaction failed")
+		}
+
+		enqueued = true
+		return
+	}, key)
+
+	return enqueued, err
+}
+
+// EnqueueIdempotent persists given task instance the same way Enqueue does, unless another task was enqueued
+// to the same queue with the same idempotency key within given window. Key of the task that does not exist
+// anymore (e.g. it was purged) is free again. If the task has uniqueness key, it is checked after
+// the idempotency key the same way EnqueueUnique does, and the idempotency key is not taken if it is held.
+// Returns ID of the task that holds the key, which is ID of the given task if it was enqueued.
+func (repo *TasksRepository) EnqueueIdempotent(
+	ctx context.Context,
+	record *models.Task,
+	idempotencyKey string,
+	window time.Duration,
+) (id string, err error) {
+
+	clientCtx := repo.redisClient.WithContext(ctx)
+	key := repo.buildKey(queuesKeyData, record.QueueId, tasksSuffixIdempotency, idempotencyKey)
+	keys := []string{key}
+	var uniqueKey string
+	if record.UniqueKey != "" {
+		uniqueKey = repo.buildKey(queuesKeyData, record.QueueId, tasksSuffixUnique, record.UniqueKey)
+		keys = append(keys, uniqueKey)
+	}
+	transaction := func(tx *redis.Tx) (err error) {
+
+		// Skip task if the key is already held
+		id, err = tx.Get(key).Result()
+		if err == redis.Nil {
+			err = nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to retrieve idempotency key")
+		}
+		if id != "" {
+			exists, err := tx.Exists(repo.buildKey(tasksKeyData, id)).Result()
+			if err != nil {
+				return errors.Wrap(err, "failed to check task holding the key")
+			}
+			if exists == 1 {
+				return nil
+			}
+		}
+
+		// Skip task if its uniqueness key is held, no task is enqueued, so the idempotency key stays free
+		if uniqueKey != "" {
+			id, err = repo.uniqueKeyHolder(tx, uniqueKey)
+			if err != nil || id != "" {
+				return err
+			}
+		}
+
+		// Save task along with the keys, transaction fails if any of the keys is taken concurrently
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) (err error) {
+			repo.save(pipe, record)
+			pipe.Set(key, record.Id, window)
+			if uniqueKey != "" {
+				pipe.Set(uniqueKey, record.Id, 0)
+			}
+			return
+		})
+		if err != nil {
+			return err
+		}
+
+		id = record.Id
+		return
+	}
+
+	// Concurrent publisher has taken one of the keys first, check them again
+	for {
+		err = clientCtx.Watch(transaction, keys...)
+		if err != redis.TxFailedErr {
+			return id, errors.Wrap(err, "transaction failed")
+		}
+	}
+}
+
+// EnqueueUnique persists given task instance the same way Enqueue does, unless another task of the same queue
+// holding the same uniqueness key is still pending, delayed or processing. The key is released once its task
+// is finished, failed, cancelled, expired or dead-lettered.
+// Returns ID of the task that holds the key, which is ID of the given task if it was enqueued.
+func (repo *TasksRepository) EnqueueUnique(ctx context.Context, record *models.Task) (id string, err error) {
+
+	clientCtx := repo.redisClient.WithContext(ctx)
+	key := repo.buildKey(queuesKeyData, record.QueueId, tasksSuffixUnique, record.UniqueKey)
+	err = clientCtx.Watch(func(tx *redis.Tx) (err error) {
+
+		// Skip task if the key is held by an unfinished task
+		id, err = repo.uniqueKeyHolder(tx, key)
+		if err != nil || id != "" {
+			return err
+		}
+
+		// Save task along with the key, transaction fails if the key is taken concurrently
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) (err error) {
+			repo.save(pipe, record)
+			pipe.Set(key, record.Id, 0)
+			return
+		})
+		if err != nil {
+			return err
+		}
+
+		id = record.Id
+		return
+	}, key)
+
+	// Concurrent publisher has taken the key first
+	if err == redis.TxFailedErr {
+		id, err = clientCtx.Get(key).Result()
+		return id, errors.Wrap(err, "failed to retrieve uniqueness key")
+	}
+
+	return id, errors.Wrap(err, "transaction failed")
+}
+
+// GetById retrieves task with given ID from the repo.
+func (repo *TasksRepository) GetById(ctx context.Context, id string) (record *models.Task, err error) {
+
+	records, err := repo.MGetById(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return records[0], nil
+}
+
+// MGetById retrieves tasks with given IDs from the repo.
+// Positions of the tasks that were not found are filled with nil.
+func (repo *TasksRepository) MGetById(ctx context.Context, ids []string) (records []*models.Task, err error) {
+
+	var (
+		dataCmds        []*redis.StringStringMapCmd
+		headersDataCmds []*redis.StringStringMapCmd
+		outputCmds      []*redis.StringCmd
+	)
+	// Pipeline reports only the first failed command, so errors are checked for each command below
+	repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		for _, id := range ids {
+			dataCmds = append(dataCmds, pipe.HGetAll(repo.buildKey(tasksKeyData, id)))
+			headersDataCmds = append(headersDataCmds, pipe.HGetAll(repo.buildKey(tasksKeyData, id, tasksSuffixHeaders)))
+			outputCmds = append(outputCmds, pipe.Get(repo.buildKey(tasksKeyData, id, tasksSuffixOutput)))
+		}
+		return
+	})
+
+	for i, dataCmd := range dataCmds {
+		if err = dataCmd.Err(); err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve task data")
+		}
+		if err = headersDataCmds[i].Err(); err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve task headers")
+		}
+		if err = outputCmds[i].Err(); err == redis.Nil {
+			err = nil // output of the unfinished or expired result does not exist
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve task output")
+		}
+		records = append(records, taskUnmarshal(dataCmd.Val(), headersDataCmds[i].Val(), outputCmds[i].Val()))
+	}
+
+	return
+}
+
+// Dequeue atomically takes the next pending task of the queue with given ID, marks it as processing
+// and leases it to a new job until the visibility timeout of the queue passes.
+// ID of the new job is set to the JobId field of the task.
+// Returns nil if there are no pending tasks, the queue is paused or the rate limit of the queue is exceeded.
+func (repo *TasksRepository) Dequeue(ctx context.Context, queueId string) (record *models.Task, err error) {
+
+	clientCtx := repo.redisClient.WithContext(ctx)
+
+	// Pop task ID
+	now := time.Now()
+	id, err := tasksScriptDequeue.Run(
+		clientCtx,
+		[]string{
+			repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixPending),
+			repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixProcessing),
+			repo.buildKey(queuesKeyData, queueId, queuesSuffixSettings),
+			repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixExpiring),
+			repo.buildKey(queuesKeyData, queueId, tasksSuffixStats),
+			repo.buildKey(queuesKeyData, queueId, tasksSuffixRateLimit),
+			repo.buildKey(queuesKeyData, queueId),
+		},
+		tasksKeyData,
+		int(models.TaskStatusProcessing),
+		xid.New().String(),
+		timeToMs(now),
+		string(models.QueueSettingVisibilityTimeout),
+		models.DefaultQueueSettings()[models.QueueSettingVisibilityTimeout],
+		int(models.TaskStatusExpired),
+		now.Format(time.RFC3339Nano),
+		tasksDequeueMaxAttempts,
+		string(models.QueueSettingRateLimitEnabled),
+		string(models.QueueSettingRateLimitTokens),
+		string(models.QueueSettingRateLimitDuration),
+	).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "dequeue script failed")
+	}
+
+	// Retrieve record
+	record, err = repo.GetById(ctx, id.(string))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve record")
+	}
+
+	return
+}
+
+// UpdateStatus changes status of the task with given ID.
+func (repo *TasksRepository) UpdateStatus(ctx context.Context, id string, status models.TaskStatus) (err error) {
+
+	err = tasksScriptUpdateStatus.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{repo.buildKey(tasksKeyData, id)},
+		id,
+		queuesKeyData,
+		int(status),
+		int(models.TaskStatusPending),
+		timeToMs(time.Now()),
+	).Err()
+
+	return errors.Wrap(err, "update status script failed")
+}
+
+// Finish marks the task with given ID as finished with given final status and sets its finish time,
+// if the job with given ID still holds the task lease. Output is kept until the result TTL of the queue passes.
+// Successfully finished task unlocks its workflow children, so those whose parents are all finished
+// are put into the pending lists of their queues. Returns false if the lease is lost.
+func (repo *TasksRepository) Finish(
+	ctx context.Context,
+	id string,
+	jobId string,
+	status models.TaskStatus,
+	output []byte,
+) (finished bool, err error) {
+
+	result, err := tasksScriptFinish.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{
+			repo.buildKey(tasksKeyData, id),
+			repo.buildKey(tasksKeyData, id, tasksSuffixOutput),
+		},
+		id,
+		queuesKeyData,
+		int(models.TaskStatusProcessing),
+		jobId,
+		int(status),
+		time.Now().Format(time.RFC3339Nano),
+		output,
+		string(models.QueueSettingResultTtl),
+		models.DefaultQueueSettings()[models.QueueSettingResultTtl],
+		int(models.TaskStatusFinished),
+		timeToMs(time.Now()),
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "finish script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// Requeue puts the task with given ID back to the pending list of its queue,
+// if the job with given ID still holds the task lease. Returns false if the lease is lost.
+func (repo *TasksRepository) Requeue(ctx context.Context, id string, jobId string) (requeued bool, err error) {
+
+	result, err := tasksScriptRequeue.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{repo.buildKey(tasksKeyData, id)},
+		id,
+		queuesKeyData,
+		int(models.TaskStatusProcessing),
+		jobId,
+		int(models.TaskStatusPending),
+		timeToMs(time.Now()),
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "requeue script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// Touch extends the lease of the task with given ID until the visibility timeout of its queue passes again,
+// if the job with given ID still holds the task lease. Returns false if the lease is lost.
+func (repo *TasksRepository) Touch(ctx context.Context, id string, jobId string) (touched bool, err error) {
+
+	result, err := tasksScriptTouch.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{repo.buildKey(tasksKeyData, id)},
+		id,
+		queuesKeyData,
+		int(models.TaskStatusProcessing),
+		jobId,
+		timeToMs(time.Now()),
+		string(models.QueueSettingVisibilityTimeout),
+		models.DefaultQueueSettings()[models.QueueSettingVisibilityTimeout],
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "touch script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// Retry records a failed attempt of the task with given ID and puts the task back to its queue,
+// to be delivered again at given run time, if the job with given ID still holds the task lease.
+// Returns false if the lease is lost.
+func (repo *TasksRepository) Retry(
+	ctx context.Context,
+	id string,
+	jobId string,
+	lastError string,
+	runAt time.Time,
+) (retried bool, err error) {
+
+	result, err := tasksScriptRetry.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{repo.buildKey(tasksKeyData, id)},
+		id,
+		queuesKeyData,
+		int(models.TaskStatusProcessing),
+		jobId,
+		int(models.TaskStatusPending),
+		int(models.TaskStatusDelayed),
+		timeToMs(time.Now()),
+		timeToMs(runAt),
+		runAt.Format(time.RFC3339Nano),
+		lastError,
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "retry script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// Fail records a failed attempt of the task with given ID and marks the task as failed,
+// if the job with given ID still holds the task lease. Tasks of the workflow that depend on it are cancelled.
+// Returns false if the lease is lost.
+func (repo *TasksRepository) Fail(ctx context.Context, id string, jobId string, lastError string) (failed bool, err error) {
+
+	result, err := tasksScriptFail.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{repo.buildKey(tasksKeyData, id)},
+		id,
+		queuesKeyData,
+		int(models.TaskStatusProcessing),
+		jobId,
+		int(models.TaskStatusFailed),
+		time.Now().Format(time.RFC3339Nano),
+		lastError,
+		timeToMs(time.Now()),
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "fail script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// DeadLetter moves the task with given ID into the pending list of the dead-letter queue with given ID
+// and adds given headers to it, if the job with given ID still holds the task lease.
+// Attempts of the task are reset, so it follows the retry policy of the dead-letter queue.
+// Tasks of the workflow that depend on it are cancelled. Returns false if the lease is lost.
+func (repo *TasksRepository) DeadLetter(
+	ctx context.Context,
+	id string,
+	jobId string,
+	lastError string,
+	queueId string,
+	headers map[string]string,
+) (moved bool, err error) {
+
+	args := []interface{}{
+		id,
+		queuesKeyData,
+		int(models.TaskStatusProcessing),
+		jobId,
+		int(models.TaskStatusPending),
+		timeToMs(time.Now()),
+		lastError,
+		queueId,
+		time.Time{}.Format(time.RFC3339Nano),
+		time.Now().Format(time.RFC3339Nano),
+	}
+	for key, value := range headers {
+		args = append(args, key, value)
+	}
+
+	result, err := tasksScriptDeadLetter.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{
+			repo.buildKey(tasksKeyData, id),
+			repo.buildKey(tasksKeyData, id, tasksSuffixHeaders),
+			repo.buildKey(queuesKeyData, queueId, queuesSuffixSettings),
+			repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixPending),
+			repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex),
+		},
+		args...,
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "dead-letter script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// RedeliverExpired puts the tasks of the queue with given ID whose leases expired before given time
+// back to the pending list and increments their redelivery counters.
+func (repo *TasksRepository) RedeliverExpired(ctx context.Context, queueId string, now time.Time) (count int, err error) {
+
+	clientCtx := repo.redisClient.WithContext(ctx)
+	for {
+		result, err := tasksScriptRedeliver.Run(
+			clientCtx,
+			[]string{
+				repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixProcessing),
+				repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixPending),
+				repo.buildKey(queuesKeyData, queueId, queuesSuffixSettings),
+			},
+			tasksKeyData,
+			timeToMs(now),
+			int(models.TaskStatusPending),
+			tasksRedeliveryBatchSize,
+		).Result()
+		if err != nil {
+			return count, errors.Wrap(err, "redeliver script failed")
+		}
+
+		count += int(result.(int64))
+		if result.(int64) < int64(tasksRedeliveryBatchSize) {
+			return count, nil
+		}
+	}
+}
+
+// ExpirePending marks pending and delayed tasks of the queue with given ID whose expiration time passed
+// before given time as expired, so they are never delivered.
+func (repo *TasksRepository) ExpirePending(ctx context.Context, queueId string, now time.Time) (count int, err error) {
+
+	clientCtx := repo.redisClient.WithContext(ctx)
+	offset := 0
+	for {
+		result, err := tasksScriptExpire.Run(
+			clientCtx,
+			[]string{
+				repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixExpiring),
+				repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixPending),
+				repo.buildKey(queuesKeyData, queueId, tasksSuffixStats),
+				repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixDelayed),
+			},
+			tasksKeyData,
+			timeToMs(now),
+			int(models.TaskStatusPending),
+			int(models.TaskStatusProcessing),
+			int(models.TaskStatusExpired),
+			now.Format(time.RFC3339Nano),
+			offset,
+			tasksExpirationBatchSize,
+			int(models.TaskStatusDelayed),
+		).Result()
+		if err != nil {
+			return count, errors.Wrap(err, "expire script failed")
+		}
+
+		// Entries of the processing tasks are kept, skip them in the next batch
+		values := result.([]interface{})
+		count += int(values[0].(int64))
+		offset += int(values[1].(int64))
+		if values[2].(int64) < int64(tasksExpirationBatchSize) {
+			return count, nil
+		}
+	}
+}
+
+// Purge deletes the pending tasks of the queue with given ID, along with the delayed ones if requested.
+// If original queue name is given, only the tasks dead-lettered from the queue with that name are deleted.
+// Tasks leased to the jobs are never deleted. Returns the number of deleted tasks.
+func (repo *TasksRepository) Purge(
+	ctx context.Context,
+	queueId string,
+	delayed bool,
+	originalQueue string,
+) (count int, err error) {
+
+	clientCtx := repo.redisClient.WithContext(ctx)
+	sets := map[string]models.TaskStatus{tasksSuffixPending: models.TaskStatusPending}
+	if delayed {
+		sets[tasksSuffixDelayed] = models.TaskStatusDelayed
+	}
+	for suffix, status := range sets {
+		offset := 0
+		for {
+			now := time.Now()
+			result, err := tasksScriptPurge.Run(
+				clientCtx,
+				[]string{
+					repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, suffix),
+					repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex),
+					repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixExpiring),
+				},
+				tasksKeyData,
+				int(status),
+				originalQueue,
+				offset,
+				tasksPurgeBatchSize,
+				timeToMs(now),
+				now.Format(time.RFC3339Nano),
+				models.TaskHeaderOriginalQueue,
+			).Result()
+			if err != nil {
+				return count, errors.Wrap(err, "purge script failed")
+			}
+
+			// Entries of the tasks that do not match are kept, skip them in the next batch
+			values := result.([]interface{})
+			count += int(values[0].(int64))
+			offset += int(values[1].(int64))
+			if values[2].(int64) < int64(tasksPurgeBatchSize) {
+				break
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// PromoteDelayed atomically moves the delayed tasks of the queue with given ID that become eligible
+// for delivery before given time to the pending list.
+func (repo *TasksRepository) PromoteDelayed(ctx context.Context, queueId string, now time.Time) (count int, err error) {
+
+	clientCtx := repo.redisClient.WithContext(ctx)
+	for {
+		result, err := tasksScriptPromote.Run(
+			clientCtx,
+			[]string{
+				repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixDelayed),
+				repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixPending),
+				repo.buildKey(queuesKeyData, queueId, queuesSuffixSettings),
+			},
+			tasksKeyData,
+			timeToMs(now),
+			int(models.TaskStatusPending),
+			tasksPromotionBatchSize,
+		).Result()
+		if err != nil {
+			return count, errors.Wrap(err, "promote script failed")
+		}
+
+		count += int(result.(int64))
+		if result.(int64) < int64(tasksPromotionBatchSize) {
+			return count, nil
+		}
+	}
+}
+
+// CountExpired returns the number of tasks of the queue with given ID that expired before delivery.
+func (repo *TasksRepository) CountExpired(ctx context.Context, queueId string) (count uint64, err error) {
+
+	count, err = repo.redisClient.WithContext(ctx).HGet(
+		repo.buildKey(queuesKeyData, queueId, tasksSuffixStats),
+		tasksStatsFieldExpired,
+	).Uint64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+
+	return count, errors.Wrap(err, "failed to retrieve counter")
+}
+
+// Stats returns live statistics of the queue with given ID at given time.
+// Every value is maintained incrementally, so no tasks are scanned.
+func (repo *TasksRepository) Stats(ctx context.Context, queueId string, now time.Time) (stats *models.QueueStats, err error) {
+
+	var (
+		pendingCmd    *redis.IntCmd
+		delayedCmd    *redis.IntCmd
+		processingCmd *redis.IntCmd
+		countersCmd   *redis.StringStringMapCmd
+		enqueuedCmd   *redis.StringStringMapCmd
+		dequeuedCmd   *redis.StringStringMapCmd
+		oldestCmd     *redis.Cmd
+	)
+	statsKey := repo.buildKey(queuesKeyData, queueId, tasksSuffixStats)
+	pendingKey := repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixPending)
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		pendingCmd = pipe.ZCard(pendingKey)
+		delayedCmd = pipe.ZCard(repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixDelayed))
+		processingCmd = pipe.ZCard(repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex, tasksSuffixProcessing))
+		countersCmd = pipe.HGetAll(statsKey)
+		enqueuedCmd = pipe.HGetAll(repo.buildKey(statsKey, tasksStatsFieldEnqueued))
+		dequeuedCmd = pipe.HGetAll(repo.buildKey(statsKey, tasksStatsFieldDequeued))
+		oldestCmd = tasksScriptOldestPending.Eval(
+			pipe,
+			[]string{pendingKey, repo.buildKey(pendingKey, tasksSuffixSince)},
+			tasksStatsMaxCleanups,
+		)
+		return
+	})
+	if err == redis.Nil {
+		err = nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "pipeline failed")
+	}
+
+	counters := countersCmd.Val()
+	counter := func(name string) (value uint64) {
+		value, _ = strconv.ParseUint(counters[name], 10, 64)
+		return
+	}
+	stats = &models.QueueStats{
+		Pending:      uint64(pendingCmd.Val()),
+		Delayed:      uint64(delayedCmd.Val()),
+		Processing:   uint64(processingCmd.Val()),
+		Finished:     counter(tasksStatsFieldFinished),
+		Failed:       counter(tasksStatsFieldFailed),
+		Expired:      counter(tasksStatsFieldExpired),
+		DeadLettered: counter(tasksStatsFieldDeadLettered),
+		Enqueued:     counter(tasksStatsFieldEnqueued),
+		Dequeued:     counter(tasksStatsFieldDequeued),
+		EnqueueRate:  taskStatsRate(enqueuedCmd.Val(), now),
+		DequeueRate:  taskStatsRate(dequeuedCmd.Val(), now),
+	}
+	if oldest, err := oldestCmd.Result(); err == nil {
+		if since, err := strconv.ParseInt(oldest.(string), 10, 64); err == nil && since < timeToMs(now) {
+			stats.OldestPendingAge = time.Duration(timeToMs(now)-since) * time.Millisecond
+		}
+	}
+
+	return
+}
+
+// Cancel atomically marks the task with given ID as cancelled, if it is still pending, delayed or waiting
+// for its workflow parents. Tasks of the workflow that depend on it are cancelled as well.
+// Returns false if the task is not pending, delayed or waiting anymore.
+func (repo *TasksRepository) Cancel(ctx context.Context, id string) (cancelled bool, err error) {
+
+	result, err := tasksScriptCancel.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{repo.buildKey(tasksKeyData, id)},
+		id,
+		queuesKeyData,
+		int(models.TaskStatusPending),
+		int(models.TaskStatusCancelled),
+		time.Now().Format(time.RFC3339Nano),
+		int(models.TaskStatusDelayed),
+		int(models.TaskStatusWaiting),
+		timeToMs(time.Now()),
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "cancel script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// FindByQueue returns a subset of the tasks of the queue with given ID, based on collection params given.
+func (repo *TasksRepository) FindByQueue(
+	ctx context.Context,
+	queueId string,
+	params *models.CollectionParams,
+) (records []*models.Task, info *models.CollectionInfo, err error) {
+
+	// Parse cursor
+	cursor, err := strconv.ParseUint(params.Cursor, 10, 64)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse cursor")
+	}
+
+	// Retrieve indexes
+	var (
+		idxCmd   *redis.ScanCmd
+		countCmd *redis.IntCmd
+	)
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		key := repo.buildKey(queuesKeyData, queueId, tasksSuffixIndex)
+		idxCmd = pipe.ZScan(key, cursor, "*", int64(params.Limit))
+		countCmd = pipe.ZCard(key)
+		return
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to scan tasks index")
+	}
+
+	// ZSCAN returns member/score pairs, keep members only
+	keys, newCursor := idxCmd.Val()
+	var ids []string
+	for i := 0; i < len(keys); i += 2 {
+		ids = append(ids, keys[i])
+	}
+
+	// Retrieve records
+	records, err = repo.MGetById(ctx, ids)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to retrieve records")
+	}
+	info = &models.CollectionInfo{
+		Total:  uint64(countCmd.Val()),
+		Cursor: strconv.FormatUint(newCursor, 10),
+	}
+
+	return
+}
+
+// save is a helper function that queues commands persisting given task instance into the pipeline given
+// and puts the task into the pending or delayed list of its queue, depending on the task status.
+// Waiting tasks are put into neither, until they are unlocked.
+func (repo *TasksRepository) save(pipe redis.Pipeliner, record *models.Task) {
+
+	key := repo.buildKey(tasksKeyData, record.Id)
+	data, headersData := taskMarshal(record)
+
+	pipe.HMSet(key, data)
+	if len(headersData) > 0 {
+		pipe.HMSet(repo.buildKey(tasksKeyData, record.Id, tasksSuffixHeaders), headersData)
+	}
+	pipe.ZAdd(repo.buildKey(queuesKeyData, record.QueueId, tasksSuffixIndex), redis.Z{
+		Member: record.Id,
+		Score:  float64(timeToMs(record.CreatedAt)),
+	})
+	if record.Status == models.TaskStatusPending {
+		tasksScriptPushPending.Eval(
+			pipe,
+			[]string{
+				repo.buildKey(queuesKeyData, record.QueueId, queuesSuffixSettings),
+				repo.buildKey(queuesKeyData, record.QueueId, tasksSuffixIndex, tasksSuffixPending),
+				key,
+			},
+			record.Id,
+			timeToMs(record.CreatedAt),
+		)
+	}
+	tasksScriptCountRate.Eval(
+		pipe,
+		[]string{repo.buildKey(queuesKeyData, record.QueueId, tasksSuffixStats)},
+		tasksStatsFieldEnqueued,
+		timeToMs(record.CreatedAt),
+	)
+	if record.Status == models.TaskStatusDelayed {
+		pipe.ZAdd(repo.buildKey(queuesKeyData, record.QueueId, tasksSuffixIndex, tasksSuffixDelayed), redis.Z{
+			Member: record.Id,
+			Score:  float64(timeToMs(record.RunAt)),
+		})
+	}
+	if !record.ExpiresAt.IsZero() {
+		pipe.ZAdd(repo.buildKey(queuesKeyData, record.QueueId, tasksSuffixIndex, tasksSuffixExpiring), redis.Z{
+			Member: record.Id,
+			Score:  float64(timeToMs(record.ExpiresAt)),
+		})
+	}
+}
+
+// uniqueKeyHolder is a helper function that returns ID of the task holding given uniqueness key within
+// the transaction. Returns empty ID if the key is free, as well as if it is stale, i.e. its task is missing
+// or already settled.
+func (repo *TasksRepository) uniqueKeyHolder(tx *redis.Tx, key string) (id string, err error) {
+
+	id, err = tx.Get(key).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to retrieve uniqueness key")
+	}
+	status, err := tx.HGet(repo.buildKey(tasksKeyData, id), "status").Result()
+	if err == redis.Nil {
+		err = nil
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to retrieve status of the key holder")
+	}
+	switch status {
+	case
+		strconv.Itoa(int(models.TaskStatusPending)),
+		strconv.Itoa(int(models.TaskStatusProcessing)),
+		strconv.Itoa(int(models.TaskStatusDelayed)):
+		return id, nil
+	}
+
+	return "", nil
+}
+
+// buildKey is a helper function that builds a Redis key from key parts given.
+func (repo *TasksRepository) buildKey(parts ...string) (key string) {
+	return strings.Join(parts, ":")
+}
+
+// taskMarshal is a helper function that marshals record into format Redis understands.
+func taskMarshal(record *models.Task) (data, headersData map[string]interface{}) {
+
+	data = make(map[string]interface{})
+	headersData = make(map[string]interface{})
+
+	data["id"] = record.Id
+	data["queue_id"] = record.QueueId
+	data["status"] = strconv.Itoa(int(record.Status))
+	data["priority"] = strconv.Itoa(int(record.Priority))
+	data["input"] = record.Input
+	data["created_at"] = record.CreatedAt.Format(time.RFC3339Nano)
+	data["expires_at"] = record.ExpiresAt.Format(time.RFC3339Nano)
+	data["run_at"] = record.RunAt.Format(time.RFC3339Nano)
+	data["finished_at"] = record.FinishedAt.Format(time.RFC3339Nano)
+	data["job_id"] = record.JobId
+	data["redeliveries"] = strconv.FormatUint(uint64(record.Redeliveries), 10)
+	data["attempts"] = strconv.FormatUint(uint64(record.Attempts), 10)
+	data["last_error"] = record.LastError
+	data["workflow_id"] = record.WorkflowId
+	data["pending_parents"] = strconv.FormatUint(uint64(record.PendingParents), 10)
+	data["batch_id"] = record.BatchId
+	data["unique_key"] = record.UniqueKey
+
+	for key, value := range record.Headers {
+		headersData[key] = value
+	}
+
+	return
+}
+
+// taskUnmarshal is a helper function that unmarshals record from Redis format.
+// Returns nil if data is empty (record does not exist).
+func taskUnmarshal(data, headersData map[string]string, output string) (record *models.Task) {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	status, _ := strconv.Atoi(data["status"])
+	priority, _ := strconv.Atoi(data["priority"])
+	createdAt, _ := time.Parse(time.RFC3339Nano, data["created_at"])
+	expiresAt, _ := time.Parse(time.RFC3339Nano, data["expires_at"])
+	runAt, _ := time.Parse(time.RFC3339Nano, data["run_at"])
+	finishedAt, _ := time.Parse(time.RFC3339Nano, data["finished_at"])
+	redeliveries, _ := strconv.ParseUint(data["redeliveries"], 10, 32)
+	attempts, _ := strconv.ParseUint(data["attempts"], 10, 32)
+	pendingParents, _ := strconv.ParseUint(data["pending_parents"], 10, 32)
+
+	record = &models.Task{
+		Id:             data["id"],
+		QueueId:        data["queue_id"],
+		Status:         models.TaskStatus(status),
+		Priority:       uint8(priority),
+		Headers:        make(map[string]string),
+		Input:          []byte(data["input"]),
+		Output:         []byte(output),
+		CreatedAt:      createdAt,
+		ExpiresAt:      expiresAt,
+		RunAt:          runAt,
+		FinishedAt:     finishedAt,
+		JobId:          data["job_id"],
+		Redeliveries:   uint32(redeliveries),
+		Attempts:       uint32(attempts),
+		LastError:      data["last_error"],
+		WorkflowId:     data["workflow_id"],
+		PendingParents: uint32(pendingParents),
+		BatchId:        data["batch_id"],
+		UniqueKey:      data["unique_key"],
+	}
+	for key, value := range headersData {
+		record.Headers[key] = value
+	}
+
+	return
+}
+
+// timeToMs is a helper function that converts time into a number of milliseconds since the Unix epoch.
+func timeToMs(t time.Time) (ms int64) {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// taskStatsRate is a helper function that measures the rate (per second) of the counter over the window
+// that ends at given time, based on the ring of per-second slots of the counter.
+func taskStatsRate(data map[string]string, now time.Time) (rate float64) {
+
+	second := now.Unix()
+	var count int64
+	for slot := int64(0); slot < tasksStatsRateWindow; slot++ {
+		slotSecond, _ := strconv.ParseInt(data["t"+strconv.FormatInt(slot, 10)], 10, 64)
+		if slotSecond <= second-tasksStatsRateWindow || slotSecond > second {
+			continue
+		}
+		slotCount, _ := strconv.ParseInt(data["c"+strconv.FormatInt(slot, 10)], 10, 64)
+		count += slotCount
+	}
+
+	return float64(count) / float64(tasksStatsRateWindow)
+}
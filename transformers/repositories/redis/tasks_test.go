@@ -0,0 +1,671 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+)
+
+// newTestRepositories starts in-memory redis server and returns repositories connected to it.
+func newTestRepositories(t *testing.T) (server *miniredis.Miniredis, tasks *TasksRepository, queues *QueuesRepository) {
+
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start redis server: %v", err)
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	return server, NewTasksRepository(client), NewQueuesRepository(client)
+}
+
+// newTestQueue saves queue with given settings.
+func newTestQueue(t *testing.T, queues *QueuesRepository, settings map[models.QueueSetting]string) *models.Queue {
+
+	queue := models.NewQueue("test", settings)
+	if err := queues.Save(context.Background(), queue); err != nil {
+		t.Fatalf("failed to save queue: %v", err)
+	}
+	return queue
+}
+
+// enqueueTestTask enqueues task to the queue with given ID.
+func enqueueTestTask(t *testing.T, tasks *TasksRepository, queueId string, expiresAt time.Time) *models.Task {
+
+	task := models.NewTask(queueId, 0, nil, []byte("input"), expiresAt, time.Time{})
+	if _, err := tasks.Enqueue(context.Background(), task); err != nil {
+		t.Fatalf("failed to enqueue task: %v", err)
+	}
+	return task
+}
+
+// assertTaskStatus fails the test if the task with given ID does not have given status.
+func assertTaskStatus(t *testing.T, tasks *TasksRepository, id string, status models.TaskStatus) {
+
+	task, err := tasks.GetById(context.Background(), id)
+	if err != nil {
+		t.Fatalf("failed to retrieve task: %v", err)
+	}
+	if task == nil || task.Status != status {
+		t.Fatalf("task %s: got %+v, want status %d", id, task, status)
+	}
+}
+
+func TestTasksRepositoryLeaseRedelivery(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, map[models.QueueSetting]string{models.QueueSettingVisibilityTimeout: "5"})
+	deadLetterQueue := newTestQueue(t, queues, nil)
+	task := enqueueTestTask(t, tasks, queue.Id, time.Time{})
+
+	stale, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || stale == nil {
+		t.Fatalf("Dequeue() = %+v, %v, want task", stale, err)
+	}
+
+	// Lease is kept until the visibility timeout passes
+	if count, err := tasks.RedeliverExpired(ctx, queue.Id, time.Now().Add(4*time.Second)); count != 0 || err != nil {
+		t.Fatalf("RedeliverExpired() before timeout = %d, %v, want 0", count, err)
+	}
+	if count, err := tasks.RedeliverExpired(ctx, queue.Id, time.Now().Add(6*time.Second)); count != 1 || err != nil {
+		t.Fatalf("RedeliverExpired() after timeout = %d, %v, want 1", count, err)
+	}
+	assertTaskStatus(t, tasks, task.Id, models.TaskStatusPending)
+
+	current, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || current == nil || current.JobId == stale.JobId || current.Redeliveries != 1 {
+		t.Fatalf("Dequeue() after redelivery = %+v, %v, want new lease", current, err)
+	}
+
+	// Job that lost the lease can not settle the task
+	transitions := map[string]func(jobId string) (bool, error){
+		"Touch": func(jobId string) (bool, error) {
+			return tasks.Touch(ctx, task.Id, jobId)
+		},
+		"Finish": func(jobId string) (bool, error) {
+			return tasks.Finish(ctx, task.Id, jobId, models.TaskStatusFinished, nil)
+		},
+		"Retry": func(jobId string) (bool, error) {
+			return tasks.Retry(ctx, task.Id, jobId, "failure", time.Now())
+		},
+		"DeadLetter": func(jobId string) (bool, error) {
+			return tasks.DeadLetter(ctx, task.Id, jobId, "failure", deadLetterQueue.Id, nil)
+		},
+	}
+	for name, transition := range transitions {
+		if ok, err := transition(stale.JobId); ok || err != nil {
+			t.Errorf("%s() with stale job = %v, %v, want false", name, ok, err)
+		}
+	}
+	assertTaskStatus(t, tasks, task.Id, models.TaskStatusProcessing)
+
+	if ok, err := tasks.Finish(ctx, task.Id, current.JobId, models.TaskStatusFinished, nil); !ok || err != nil {
+		t.Fatalf("Finish() with current job = %v, %v, want true", ok, err)
+	}
+	assertTaskStatus(t, tasks, task.Id, models.TaskStatusFinished)
+}
+
+func TestTasksRepositoryDequeueExpired(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, nil)
+	expired := enqueueTestTask(t, tasks, queue.Id, time.Now().Add(50*time.Millisecond))
+	fresh := enqueueTestTask(t, tasks, queue.Id, time.Now().Add(time.Hour))
+
+	time.Sleep(100 * time.Millisecond)
+	got, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || got == nil || got.Id != fresh.Id {
+		t.Fatalf("Dequeue() = %+v, %v, want task %s", got, err, fresh.Id)
+	}
+	assertTaskStatus(t, tasks, expired.Id, models.TaskStatusExpired)
+
+	if count, err := tasks.CountExpired(ctx, queue.Id); count != 1 || err != nil {
+		t.Fatalf("CountExpired() = %d, %v, want 1", count, err)
+	}
+}
+
+func TestTasksRepositoryExpirePending(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, nil)
+	now := time.Now()
+	pending := enqueueTestTask(t, tasks, queue.Id, now.Add(time.Hour))
+	delayed := models.NewTask(queue.Id, 0, nil, nil, now.Add(time.Hour), now.Add(time.Minute))
+	if _, err := tasks.Enqueue(ctx, delayed); err != nil {
+		t.Fatalf("failed to enqueue task: %v", err)
+	}
+	eternal := enqueueTestTask(t, tasks, queue.Id, time.Time{})
+
+	if count, err := tasks.ExpirePending(ctx, queue.Id, now.Add(time.Minute)); count != 0 || err != nil {
+		t.Fatalf("ExpirePending() before expiration = %d, %v, want 0", count, err)
+	}
+	if count, err := tasks.ExpirePending(ctx, queue.Id, now.Add(2*time.Hour)); count != 2 || err != nil {
+		t.Fatalf("ExpirePending() after expiration = %d, %v, want 2", count, err)
+	}
+	assertTaskStatus(t, tasks, pending.Id, models.TaskStatusExpired)
+	assertTaskStatus(t, tasks, delayed.Id, models.TaskStatusExpired)
+
+	got, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || got == nil || got.Id != eternal.Id {
+		t.Fatalf("Dequeue() = %+v, %v, want task %s", got, err, eternal.Id)
+	}
+	if count, err := tasks.CountExpired(ctx, queue.Id); count != 2 || err != nil {
+		t.Fatalf("CountExpired() = %d, %v, want 2", count, err)
+	}
+}
+
+func TestTasksRepositoryDequeuePriority(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	now := time.Now()
+
+	cases := []struct {
+		settings   map[models.QueueSetting]string
+		priorities []uint8         // priorities of the tasks, enqueued in this order
+		ages       []time.Duration // how long before now the tasks were created
+		order      []int           // expected delivery order, as indices of the tasks
+	}{
+		{
+			settings:   map[models.QueueSetting]string{models.QueueSettingPriorityMode: models.QueuePriorityModeStrict},
+			priorities: []uint8{0, 5, 5, 255, 0},
+			ages:       []time.Duration{5 * time.Second, 4 * time.Second, 3 * time.Second, 2 * time.Second, time.Second},
+			order:      []int{3, 1, 2, 0, 4},
+		},
+		{
+			// one second of waiting is worth one priority level
+			settings: map[models.QueueSetting]string{
+				models.QueueSettingPriorityMode:   models.QueuePriorityModeWeighted,
+				models.QueueSettingPriorityWeight: "1",
+			},
+			priorities: []uint8{0, 5, 20},
+			ages:       []time.Duration{10 * time.Second, 0, 0},
+			order:      []int{2, 0, 1},
+		},
+	}
+
+	for i, c := range cases {
+		queue := newTestQueue(t, queues, c.settings)
+		var ids []string
+		for j, priority := range c.priorities {
+			task := models.NewTask(queue.Id, priority, nil, nil, time.Time{}, time.Time{})
+			task.CreatedAt = now.Add(-c.ages[j])
+			if _, err := tasks.Enqueue(ctx, task); err != nil {
+				t.Fatalf("failed to enqueue task: %v", err)
+			}
+			ids = append(ids, task.Id)
+		}
+		for _, index := range c.order {
+			got, err := tasks.Dequeue(ctx, queue.Id)
+			if err != nil || got == nil || got.Id != ids[index] {
+				t.Fatalf("case %d: Dequeue() = %+v, %v, want task %d", i, got, err, index)
+			}
+		}
+		if got, err := tasks.Dequeue(ctx, queue.Id); got != nil || err != nil {
+			t.Fatalf("case %d: Dequeue() from empty queue = %+v, %v, want nothing", i, got, err)
+		}
+	}
+}
+
+func TestTasksRepositoryPromoteDelayed(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, nil)
+	now := time.Now()
+
+	later := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, now.Add(2*time.Second))
+	sooner := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, now.Add(time.Second))
+	distant := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, now.Add(time.Hour))
+	immediate := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, time.Time{})
+	for _, task := range []*models.Task{later, sooner, distant, immediate} {
+		if _, err := tasks.Enqueue(ctx, task); err != nil {
+			t.Fatalf("failed to enqueue task: %v", err)
+		}
+	}
+	assertTaskStatus(t, tasks, later.Id, models.TaskStatusDelayed)
+	assertTaskStatus(t, tasks, immediate.Id, models.TaskStatusPending)
+
+	// Delayed tasks are not delivered before their run time
+	if got, err := tasks.Dequeue(ctx, queue.Id); err != nil || got == nil || got.Id != immediate.Id {
+		t.Fatalf("Dequeue() = %+v, %v, want task %s", got, err, immediate.Id)
+	}
+	if got, err := tasks.Dequeue(ctx, queue.Id); got != nil || err != nil {
+		t.Fatalf("Dequeue() of delayed task = %+v, %v, want nothing", got, err)
+	}
+
+	// Promoted tasks are delivered in the order of their run times
+	if count, err := tasks.PromoteDelayed(ctx, queue.Id, now.Add(3*time.Second)); count != 2 || err != nil {
+		t.Fatalf("PromoteDelayed() = %d, %v, want 2", count, err)
+	}
+	assertTaskStatus(t, tasks, later.Id, models.TaskStatusPending)
+	for _, want := range []*models.Task{sooner, later} {
+		got, err := tasks.Dequeue(ctx, queue.Id)
+		if err != nil || got == nil || got.Id != want.Id || !got.RunAt.Equal(want.RunAt) {
+			t.Fatalf("Dequeue() = %+v, %v, want task %s", got, err, want.Id)
+		}
+	}
+
+	// Cancelled delayed task is never promoted
+	if ok, err := tasks.Cancel(ctx, distant.Id); !ok || err != nil {
+		t.Fatalf("Cancel() = %v, %v, want true", ok, err)
+	}
+	if count, err := tasks.PromoteDelayed(ctx, queue.Id, now.Add(2*time.Hour)); count != 0 || err != nil {
+		t.Fatalf("PromoteDelayed() of cancelled task = %d, %v, want 0", count, err)
+	}
+}
+
+func TestTasksRepositoryDeadLetter(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, nil)
+	deadLetterQueue := newTestQueue(t, queues, nil)
+	task := enqueueTestTask(t, tasks, queue.Id, time.Now().Add(time.Hour))
+
+	// Failed attempt is recorded before the move
+	leased, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || leased == nil {
+		t.Fatalf("Dequeue() = %+v, %v, want task", leased, err)
+	}
+	if ok, err := tasks.Retry(ctx, task.Id, leased.JobId, "first", time.Now()); !ok || err != nil {
+		t.Fatalf("Retry() = %v, %v, want true", ok, err)
+	}
+	if leased, err = tasks.Dequeue(ctx, queue.Id); err != nil || leased == nil {
+		t.Fatalf("Dequeue() = %+v, %v, want task", leased, err)
+	}
+	headers := map[string]string{models.TaskHeaderDeadLetterReason: models.DeadLetterReasonExhausted}
+	if ok, err := tasks.DeadLetter(ctx, task.Id, leased.JobId, "second", deadLetterQueue.Id, headers); !ok || err != nil {
+		t.Fatalf("DeadLetter() = %v, %v, want true", ok, err)
+	}
+
+	// Task leaves the original queue
+	if got, err := tasks.Dequeue(ctx, queue.Id); got != nil || err != nil {
+		t.Fatalf("Dequeue() from original queue = %+v, %v, want nothing", got, err)
+	}
+	if records, _, err := tasks.FindByQueue(ctx, queue.Id, models.NewCollectionParams("0", 0)); len(records) != 0 || err != nil {
+		t.Fatalf("FindByQueue() of original queue = %v, %v, want none", records, err)
+	}
+
+	// Task is delivered from the dead-letter queue with reset attempts, no expiration and the headers added
+	got, err := tasks.Dequeue(ctx, deadLetterQueue.Id)
+	if err != nil || got == nil || got.Id != task.Id {
+		t.Fatalf("Dequeue() from dead-letter queue = %+v, %v, want task %s", got, err, task.Id)
+	}
+	if got.QueueId != deadLetterQueue.Id || got.Attempts != 0 || got.LastError != "second" || !got.ExpiresAt.IsZero() {
+		t.Fatalf("dead-lettered task = %+v, want moved task without attempts and expiration", got)
+	}
+	if got.Headers[models.TaskHeaderDeadLetterReason] != models.DeadLetterReasonExhausted {
+		t.Fatalf("dead-lettered task headers = %v, want dead-letter reason", got.Headers)
+	}
+	if history := got.Headers[models.TaskHeaderAttemptErrors]; history != `["first","second"]` {
+		t.Fatalf("dead-lettered task attempt errors = %s, want both attempts", history)
+	}
+}
+
+func TestTasksRepositoryDequeueRateLimit(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, map[models.QueueSetting]string{
+		models.QueueSettingRateLimitEnabled:  "1",
+		models.QueueSettingRateLimitTokens:   "2",
+		models.QueueSettingRateLimitDuration: "1",
+	})
+	for i := 0; i < 5; i++ {
+		enqueueTestTask(t, tasks, queue.Id, time.Time{})
+	}
+
+	delivered := 0
+	for i := 0; i < 5; i++ {
+		got, err := tasks.Dequeue(ctx, queue.Id)
+		if err != nil {
+			t.Fatalf("Dequeue() failed: %v", err)
+		}
+		if got != nil {
+			delivered++
+		}
+	}
+	if delivered != 2 {
+		t.Fatalf("Dequeue() delivered %d tasks within the burst, want 2", delivered)
+	}
+
+	// One token is refilled every half of a second
+	time.Sleep(600 * time.Millisecond)
+	if got, err := tasks.Dequeue(ctx, queue.Id); got == nil || err != nil {
+		t.Fatalf("Dequeue() after refill = %+v, %v, want task", got, err)
+	}
+	if got, err := tasks.Dequeue(ctx, queue.Id); got != nil || err != nil {
+		t.Fatalf("Dequeue() over the limit = %+v, %v, want nothing", got, err)
+	}
+}
+
+func TestTasksRepositoryEnqueueIdempotent(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, nil)
+
+	first := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, time.Time{})
+	if id, err := tasks.EnqueueIdempotent(ctx, first, "key", time.Hour); id != first.Id || err != nil {
+		t.Fatalf("EnqueueIdempotent() = %s, %v, want %s", id, err, first.Id)
+	}
+
+	// Key is held within the window even after the task is settled
+	leased, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || leased == nil {
+		t.Fatalf("Dequeue() = %+v, %v, want task", leased, err)
+	}
+	if ok, err := tasks.Finish(ctx, first.Id, leased.JobId, models.TaskStatusFinished, nil); !ok || err != nil {
+		t.Fatalf("Finish() = %v, %v, want true", ok, err)
+	}
+	duplicate := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, time.Time{})
+	if id, err := tasks.EnqueueIdempotent(ctx, duplicate, "key", time.Hour); id != first.Id || err != nil {
+		t.Fatalf("EnqueueIdempotent() of duplicate = %s, %v, want %s", id, err, first.Id)
+	}
+	if task, err := tasks.GetById(ctx, duplicate.Id); task != nil || err != nil {
+		t.Fatalf("duplicate task = %+v, %v, want nothing", task, err)
+	}
+
+	// Other keys are independent
+	other := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, time.Time{})
+	if id, err := tasks.EnqueueIdempotent(ctx, other, "other", time.Hour); id != other.Id || err != nil {
+		t.Fatalf("EnqueueIdempotent() with other key = %s, %v, want %s", id, err, other.Id)
+	}
+
+	// Key of the task that does not exist anymore is free
+	if count, err := tasks.Purge(ctx, queue.Id, false, ""); count != 1 || err != nil {
+		t.Fatalf("Purge() = %d, %v, want 1", count, err)
+	}
+	retry := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, time.Time{})
+	if id, err := tasks.EnqueueIdempotent(ctx, retry, "other", time.Hour); id != retry.Id || err != nil {
+		t.Fatalf("EnqueueIdempotent() after purge = %s, %v, want %s", id, err, retry.Id)
+	}
+}
+
+func TestTasksRepositoryEnqueueUnique(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, nil)
+
+	newUniqueTask := func() *models.Task {
+		task := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, time.Time{})
+		task.UniqueKey = "key"
+		return task
+	}
+
+	first := newUniqueTask()
+	if id, err := tasks.EnqueueUnique(ctx, first); id != first.Id || err != nil {
+		t.Fatalf("EnqueueUnique() = %s, %v, want %s", id, err, first.Id)
+	}
+
+	// Key is held while the task is pending or processing
+	if id, err := tasks.EnqueueUnique(ctx, newUniqueTask()); id != first.Id || err != nil {
+		t.Fatalf("EnqueueUnique() of pending duplicate = %s, %v, want %s", id, err, first.Id)
+	}
+	leased, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || leased == nil {
+		t.Fatalf("Dequeue() = %+v, %v, want task", leased, err)
+	}
+	if id, err := tasks.EnqueueUnique(ctx, newUniqueTask()); id != first.Id || err != nil {
+		t.Fatalf("EnqueueUnique() of processing duplicate = %s, %v, want %s", id, err, first.Id)
+	}
+
+	// Key is released once the task is settled
+	if ok, err := tasks.Finish(ctx, first.Id, leased.JobId, models.TaskStatusFinished, nil); !ok || err != nil {
+		t.Fatalf("Finish() = %v, %v, want true", ok, err)
+	}
+	second := newUniqueTask()
+	if id, err := tasks.EnqueueUnique(ctx, second); id != second.Id || err != nil {
+		t.Fatalf("EnqueueUnique() after finish = %s, %v, want %s", id, err, second.Id)
+	}
+	if count, err := tasks.Purge(ctx, queue.Id, false, ""); count != 1 || err != nil {
+		t.Fatalf("Purge() = %d, %v, want 1", count, err)
+	}
+	third := newUniqueTask()
+	if id, err := tasks.EnqueueUnique(ctx, third); id != third.Id || err != nil {
+		t.Fatalf("EnqueueUnique() after purge = %s, %v, want %s", id, err, third.Id)
+	}
+}
+
+func TestTasksRepositoryEnqueueIdempotentUnique(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, nil)
+
+	newUniqueTask := func() *models.Task {
+		task := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, time.Time{})
+		task.UniqueKey = "unique"
+		return task
+	}
+
+	first := newUniqueTask()
+	if id, err := tasks.EnqueueIdempotent(ctx, first, "first", time.Hour); id != first.Id || err != nil {
+		t.Fatalf("EnqueueIdempotent() = %s, %v, want %s", id, err, first.Id)
+	}
+
+	// Idempotency key is checked first
+	if id, err := tasks.EnqueueIdempotent(ctx, newUniqueTask(), "first", time.Hour); id != first.Id || err != nil {
+		t.Fatalf("EnqueueIdempotent() with taken keys = %s, %v, want %s", id, err, first.Id)
+	}
+
+	// Held uniqueness key blocks the task and keeps its idempotency key free
+	if id, err := tasks.EnqueueIdempotent(ctx, newUniqueTask(), "second", time.Hour); id != first.Id || err != nil {
+		t.Fatalf("EnqueueIdempotent() with taken uniqueness key = %s, %v, want %s", id, err, first.Id)
+	}
+	leased, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || leased == nil {
+		t.Fatalf("Dequeue() = %+v, %v, want task", leased, err)
+	}
+	if ok, err := tasks.Finish(ctx, first.Id, leased.JobId, models.TaskStatusFinished, nil); !ok || err != nil {
+		t.Fatalf("Finish() = %v, %v, want true", ok, err)
+	}
+	second := newUniqueTask()
+	if id, err := tasks.EnqueueIdempotent(ctx, second, "second", time.Hour); id != second.Id || err != nil {
+		t.Fatalf("EnqueueIdempotent() after release = %s, %v, want %s", id, err, second.Id)
+	}
+
+	// Both keys are taken by the enqueued task
+	if id, err := tasks.EnqueueUnique(ctx, newUniqueTask()); id != second.Id || err != nil {
+		t.Fatalf("EnqueueUnique() = %s, %v, want %s", id, err, second.Id)
+	}
+	if id, err := tasks.EnqueueIdempotent(ctx, newUniqueTask(), "second", time.Hour); id != second.Id || err != nil {
+		t.Fatalf("EnqueueIdempotent() = %s, %v, want %s", id, err, second.Id)
+	}
+}
+
+func TestTasksRepositoryDequeuePaused(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, nil)
+	task := enqueueTestTask(t, tasks, queue.Id, time.Time{})
+
+	if _, err := queues.SetPaused(ctx, queue.Id, true); err != nil {
+		t.Fatalf("failed to pause queue: %v", err)
+	}
+	if got, err := tasks.Dequeue(ctx, queue.Id); got != nil || err != nil {
+		t.Fatalf("Dequeue() from paused queue = %+v, %v, want nothing", got, err)
+	}
+	assertTaskStatus(t, tasks, task.Id, models.TaskStatusPending)
+
+	if _, err := queues.SetPaused(ctx, queue.Id, false); err != nil {
+		t.Fatalf("failed to resume queue: %v", err)
+	}
+	got, err := tasks.Dequeue(ctx, queue.Id)
+	if err != nil || got == nil || got.Id != task.Id || got.JobId == "" {
+		t.Fatalf("Dequeue() from resumed queue = %+v, %v, want leased task %s", got, err, task.Id)
+	}
+	assertTaskStatus(t, tasks, task.Id, models.TaskStatusProcessing)
+}
+
+func TestTasksRepositoryPurge(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, nil)
+	leased := enqueueTestTask(t, tasks, queue.Id, time.Time{})
+	if _, err := tasks.Dequeue(ctx, queue.Id); err != nil {
+		t.Fatalf("Dequeue() failed: %v", err)
+	}
+	pending := enqueueTestTask(t, tasks, queue.Id, time.Now().Add(time.Hour))
+	delayed := models.NewTask(queue.Id, 0, nil, nil, time.Time{}, time.Now().Add(time.Hour))
+	if _, err := tasks.Enqueue(ctx, delayed); err != nil {
+		t.Fatalf("failed to enqueue task: %v", err)
+	}
+
+	if count, err := tasks.Purge(ctx, queue.Id, false, ""); count != 1 || err != nil {
+		t.Fatalf("Purge() = %d, %v, want 1", count, err)
+	}
+	if task, err := tasks.GetById(ctx, pending.Id); task != nil || err != nil {
+		t.Fatalf("purged task = %+v, %v, want nothing", task, err)
+	}
+	assertTaskStatus(t, tasks, delayed.Id, models.TaskStatusDelayed)
+
+	if count, err := tasks.Purge(ctx, queue.Id, true, ""); count != 1 || err != nil {
+		t.Fatalf("Purge() with delayed = %d, %v, want 1", count, err)
+	}
+	assertTaskStatus(t, tasks, leased.Id, models.TaskStatusProcessing)
+
+	if count, err := tasks.CountExpired(ctx, queue.Id); count != 0 || err != nil {
+		t.Fatalf("CountExpired() after purge = %d, %v, want 0", count, err)
+	}
+}
+
+func TestTasksRepositoryPurgeDeadLettered(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	deadLetterQueue := newTestQueue(t, queues, nil)
+
+	// Dead-letter queue holds tasks from two queues and a task published to it directly
+	var deadLettered []*models.Task
+	for _, name := range []string{"a", "b"} {
+		queue := newTestQueue(t, queues, nil)
+		task := enqueueTestTask(t, tasks, queue.Id, time.Time{})
+		leased, err := tasks.Dequeue(ctx, queue.Id)
+		if err != nil || leased == nil {
+			t.Fatalf("Dequeue() = %+v, %v, want task", leased, err)
+		}
+		headers := map[string]string{models.TaskHeaderOriginalQueue: name}
+		if ok, err := tasks.DeadLetter(ctx, task.Id, leased.JobId, "failure", deadLetterQueue.Id, headers); !ok || err != nil {
+			t.Fatalf("DeadLetter() = %v, %v, want true", ok, err)
+		}
+		deadLettered = append(deadLettered, task)
+	}
+	direct := enqueueTestTask(t, tasks, deadLetterQueue.Id, time.Time{})
+
+	if count, err := tasks.Purge(ctx, deadLetterQueue.Id, false, "a"); count != 1 || err != nil {
+		t.Fatalf("Purge() of tasks from a = %d, %v, want 1", count, err)
+	}
+	if task, err := tasks.GetById(ctx, deadLettered[0].Id); task != nil || err != nil {
+		t.Fatalf("purged task = %+v, %v, want nothing", task, err)
+	}
+	assertTaskStatus(t, tasks, deadLettered[1].Id, models.TaskStatusPending)
+	assertTaskStatus(t, tasks, direct.Id, models.TaskStatusPending)
+}
+
+func TestTasksRepositoryStats(t *testing.T) {
+
+	ctx := context.Background()
+	server, tasks, queues := newTestRepositories(t)
+	defer server.Close()
+	queue := newTestQueue(t, queues, nil)
+	deadLetterQueue := newTestQueue(t, queues, nil)
+	now := time.Now()
+
+	enqueue := func(priority uint8, createdAt, expiresAt, runAt time.Time) *models.Task {
+		task := models.NewTask(queue.Id, priority, nil, nil, expiresAt, runAt)
+		task.CreatedAt = createdAt
+		if _, err := tasks.Enqueue(ctx, task); err != nil {
+			t.Fatalf("failed to enqueue task: %v", err)
+		}
+		return task
+	}
+
+	// Four tasks are delivered, one is waiting with the lowest priority, one is delayed and one expires
+	for i := 0; i < 4; i++ {
+		enqueue(1, now, time.Time{}, time.Time{})
+	}
+	enqueue(0, now.Add(-10*time.Second), time.Time{}, time.Time{})
+	enqueue(1, now, time.Time{}, now.Add(time.Hour))
+	enqueue(1, now, now.Add(time.Minute), time.Time{})
+	if count, err := tasks.ExpirePending(ctx, queue.Id, now.Add(2*time.Minute)); count != 1 || err != nil {
+		t.Fatalf("ExpirePending() = %d, %v, want 1", count, err)
+	}
+	var leased []*models.Task
+	for i := 0; i < 4; i++ {
+		task, err := tasks.Dequeue(ctx, queue.Id)
+		if err != nil || task == nil {
+			t.Fatalf("Dequeue() = %+v, %v, want task", task, err)
+		}
+		leased = append(leased, task)
+	}
+	if ok, err := tasks.Finish(ctx, leased[0].Id, leased[0].JobId, models.TaskStatusFinished, nil); !ok || err != nil {
+		t.Fatalf("Finish() = %v, %v, want true", ok, err)
+	}
+	if ok, err := tasks.Fail(ctx, leased[1].Id, leased[1].JobId, "failure"); !ok || err != nil {
+		t.Fatalf("Fail() = %v, %v, want true", ok, err)
+	}
+	if ok, err := tasks.DeadLetter(ctx, leased[2].Id, leased[2].JobId, "failure", deadLetterQueue.Id, nil); !ok || err != nil {
+		t.Fatalf("DeadLetter() = %v, %v, want true", ok, err)
+	}
+
+	stats, err := tasks.Stats(ctx, queue.Id, now)
+	if err != nil {
+		t.Fatalf("Stats() failed: %v", err)
+	}
+	expected := models.QueueStats{
+		Pending:      1,
+		Delayed:      1,
+		Processing:   1,
+		Finished:     1,
+		Failed:       1,
+		Expired:      1,
+		DeadLettered: 1,
+		Enqueued:     7,
+		Dequeued:     4,
+		EnqueueRate:  7.0 / 60,
+		DequeueRate:  4.0 / 60,
+	}
+	if age := stats.OldestPendingAge; age < 10*time.Second || age > 11*time.Second {
+		t.Fatalf("Stats() oldest pending age = %s, want 10s", age)
+	}
+	stats.OldestPendingAge = 0
+	if *stats != expected {
+		t.Fatalf("Stats() = %+v, want %+v", *stats, expected)
+	}
+
+	// Dead-lettered task is counted by the dead-letter queue, empty queue has no pending age
+	stats, err = tasks.Stats(ctx, deadLetterQueue.Id, now)
+	if err != nil {
+		t.Fatalf("Stats() failed: %v", err)
+	}
+	if stats.Pending != 1 || stats.Enqueued != 1 || stats.DeadLettered != 0 {
+		t.Fatalf("Stats() of dead-letter queue = %+v, want one pending task", stats)
+	}
+	if stats, err = tasks.Stats(ctx, "missing", now); err != nil || *stats != (models.QueueStats{}) {
+		t.Fatalf("Stats() of missing queue = %+v, %v, want zero stats", stats, err)
+	}
+}
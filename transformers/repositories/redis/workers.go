@@ -0,0 +1,422 @@
+package redis
+
+import (
+	"context"
+
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	workersKeyData             string = "workers"
+	workersSuffixQueues        string = "queues"
+	workersSuffixJobs          string = "jobs"
+	workersKeyIndexById        string = "workers:index:id"
+	workersKeyIndexByHeartbeat string = "workers:index:heartbeat"
+
+	workersDeadRetention = 24 * time.Hour // how long dead workers are kept for inspection
+)
+
+var (
+	// workersScriptHeartbeat sets last heartbeat time of the worker, if it exists and is alive.
+	//
+	// KEYS[1] - worker data key;
+	// KEYS[2] - heartbeat index;
+	// ARGV[1] - worker ID;
+	// ARGV[2] - alive status;
+	// ARGV[3] - heartbeat time;
+	// ARGV[4] - heartbeat time (ms).
+	workersScriptHeartbeat = redis.NewScript(`
+		local status = redis.call('HGET', KEYS[1], 'status')
+		if status ~= ARGV[2] then
+			return 0
+		end
+		redis.call('HSET', KEYS[1], 'heartbeat_at', ARGV[3])
+		redis.call('ZADD', KEYS[2], ARGV[4], ARGV[1])
+		return 1
+	`)
+
+	// workersScriptMarkDead marks worker as dead, if it is alive and its last heartbeat is not after the deadline.
+	// Dead worker is removed from the indexes and is kept for inspection for a while. Worker that does not exist
+	// or is not alive is removed from the indexes as well, so stale index entries never pile up.
+	// Returns IDs of the jobs the worker was holding or false if the worker is not marked.
+	//
+	// KEYS[1] - worker data key;
+	// KEYS[2] - worker queues key;
+	// KEYS[3] - worker jobs key;
+	// KEYS[4] - ID index;
+	// KEYS[5] - heartbeat index;
+	// ARGV[1] - worker ID;
+	// ARGV[2] - alive status;
+	// ARGV[3] - dead status;
+	// ARGV[4] - deadline (ms);
+	// ARGV[5] - retention (ms).
+	workersScriptMarkDead = redis.NewScript(`
+		local status = redis.call('HGET', KEYS[1], 'status')
+		if status ~= ARGV[2] then
+			redis.call('ZREM', KEYS[4], ARGV[1])
+			redis.call('ZREM', KEYS[5], ARGV[1])
+			return false
+		end
+		local score = redis.call('ZSCORE', KEYS[5], ARGV[1])
+		if score and tonumber(score) > tonumber(ARGV[4]) then
+			return false
+		end
+		local jobIds = redis.call('SMEMBERS', KEYS[3])
+		redis.call('HSET', KEYS[1], 'status', ARGV[3])
+		redis.call('ZREM', KEYS[4], ARGV[1])
+		redis.call('ZREM', KEYS[5], ARGV[1])
+		redis.call('DEL', KEYS[3])
+		redis.call('PEXPIRE', KEYS[1], ARGV[5])
+		redis.call('PEXPIRE', KEYS[2], ARGV[5])
+		return jobIds
+	`)
+
+	// workersScriptAddJob adds job to the list of jobs the worker is holding, if the worker exists and is alive.
+	//
+	// KEYS[1] - worker data key;
+	// KEYS[2] - worker jobs key;
+	// ARGV[1] - job ID;
+	// ARGV[2] - alive status.
+	workersScriptAddJob = redis.NewScript(`
+		local status = redis.call('HGET', KEYS[1], 'status')
+		if status ~= ARGV[2] then
+			return 0
+		end
+		redis.call('SADD', KEYS[2], ARGV[1])
+		return 1
+	`)
+)
+
+// NewWorkersRepository creates a new instance of WorkersRepository.
+func NewWorkersRepository(redisClient *redis.Client) (repo *WorkersRepository) {
+	return &WorkersRepository{
+		redisClient: redisClient,
+	}
+}
+
+// WorkersRepository implements a Redis-based workers repository.
+//
+// Redis schema:
+//   - HASH: `workers:<worker ID>`.
+//     Generic worker information.
+//     Fields:
+//       - `id`;
+//       - `name`;
+//       - `hostname`;
+//       - `version`;
+//       - `concurrency`;
+//       - `status`;
+//       - `created_at`;
+//       - `heartbeat_at`.
+//   - LIST: `workers:<worker ID>:queues`.
+//     Names of the subscribed queues.
+//   - SET: `workers:<worker ID>:jobs`.
+//     IDs of the jobs the worker is holding.
+//   - SORTED SET: `workers:index:id`.
+//     An index containing IDs of all live workers and registration timestamp (ms) as a score.
+//   - SORTED SET: `workers:index:heartbeat`.
+//     An index containing IDs of all live workers and last heartbeat timestamp (ms) as a score.
+type WorkersRepository struct {
+	redisClient *redis.Client // redis client instance
+}
+
+// Save persists given worker instance to the repo.
+func (repo *WorkersRepository) Save(ctx context.Context, record *models.Worker) (err error) {
+
+	data, queuesData := workerMarshal(record)
+	_, err = repo.redisClient.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) (err error) {
+		pipe.HMSet(repo.buildKey(workersKeyData, record.Id), data)
+		pipe.Del(repo.buildKey(workersKeyData, record.Id, workersSuffixQueues))
+		if len(queuesData) > 0 {
+			pipe.RPush(repo.buildKey(workersKeyData, record.Id, workersSuffixQueues), queuesData...)
+		}
+		pipe.ZAdd(repo.buildKey(workersKeyIndexById), redis.Z{
+			Member: record.Id,
+			Score:  float64(timeToMs(record.CreatedAt)),
+		})
+		pipe.ZAdd(repo.buildKey(workersKeyIndexByHeartbeat), redis.Z{
+			Member: record.Id,
+			Score:  float64(timeToMs(record.HeartbeatAt)),
+		})
+		return
+	})
+
+	return errors.Wrap(err, "transaction failed")
+}
+
+// GetById retrieves worker with given ID from the repo.
+func (repo *WorkersRepository) GetById(ctx context.Context, id string) (record *models.Worker, err error) {
+
+	records, err := repo.MGetById(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return records[0], nil
+}
+
+// MGetById retrieves workers with given IDs from the repo.
+// Positions of the workers that were not found are filled with nil.
+func (repo *WorkersRepository) MGetById(ctx context.Context, ids []string) (records []*models.Worker, err error) {
+
+	var (
+		dataCmds   []*redis.StringStringMapCmd
+		queuesCmds []*redis.StringSliceCmd
+		jobsCmds   []*redis.StringSliceCmd
+	)
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		for _, id := range ids {
+			dataCmds = append(dataCmds, pipe.HGetAll(repo.buildKey(workersKeyData, id)))
+			queuesCmds = append(queuesCmds, pipe.LRange(repo.buildKey(workersKeyData, id, workersSuffixQueues), 0, -1))
+			jobsCmds = append(jobsCmds, pipe.SMembers(repo.buildKey(workersKeyData, id, workersSuffixJobs)))
+		}
+		return
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "pipeline failed")
+	}
+
+	for i, dataCmd := range dataCmds {
+		records = append(records, workerUnmarshal(dataCmd.Val(), queuesCmds[i].Val(), jobsCmds[i].Val()))
+	}
+
+	return
+}
+
+// Find returns a subset of the live workers, based on collection params given.
+func (repo *WorkersRepository) Find(
+	ctx context.Context,
+	params *models.CollectionParams,
+) (records []*models.Worker, info *models.CollectionInfo, err error) {
+
+	// Parse cursor
+	cursor, err := strconv.ParseUint(params.Cursor, 10, 64)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse cursor")
+	}
+
+	// Retrieve indexes
+	var (
+		idxCmd   *redis.ScanCmd
+		countCmd *redis.IntCmd
+	)
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		key := repo.buildKey(workersKeyIndexById)
+		idxCmd = pipe.ZScan(key, cursor, "*", int64(params.Limit))
+		countCmd = pipe.ZCard(key)
+		return
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to scan workers index")
+	}
+
+	// ZSCAN returns member/score pairs, keep members only
+	keys, newCursor := idxCmd.Val()
+	var ids []string
+	for i := 0; i < len(keys); i += 2 {
+		ids = append(ids, keys[i])
+	}
+
+	// Retrieve records
+	records, err = repo.MGetById(ctx, ids)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to retrieve records")
+	}
+	info = &models.CollectionInfo{
+		Total:  uint64(countCmd.Val()),
+		Cursor: strconv.FormatUint(newCursor, 10),
+	}
+
+	return
+}
+
+// FindStale returns up to limit live workers whose last heartbeat is not after given time.
+// Index entries of the workers that do not exist anymore are dropped, their positions are filled with nil.
+func (repo *WorkersRepository) FindStale(
+	ctx context.Context,
+	heartbeatBefore time.Time,
+	limit int,
+) (records []*models.Worker, err error) {
+
+	// Retrieve index
+	ids, err := repo.redisClient.WithContext(ctx).ZRangeByScore(repo.buildKey(workersKeyIndexByHeartbeat), redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(timeToMs(heartbeatBefore), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to range workers index")
+	}
+
+	// Retrieve records
+	records, err = repo.MGetById(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve records")
+	}
+
+	// Drop index entries of the workers that do not exist anymore, so they are not returned again
+	var missingIds []interface{}
+	for i, record := range records {
+		if record == nil {
+			missingIds = append(missingIds, ids[i])
+		}
+	}
+	if len(missingIds) > 0 {
+		_, err = repo.redisClient.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) (err error) {
+			pipe.ZRem(repo.buildKey(workersKeyIndexById), missingIds...)
+			pipe.ZRem(repo.buildKey(workersKeyIndexByHeartbeat), missingIds...)
+			return
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to clean workers index")
+		}
+	}
+
+	return
+}
+
+// Heartbeat atomically sets last heartbeat time of the worker with given ID, if it is alive.
+// Returns false if the worker does not exist or is dead.
+func (repo *WorkersRepository) Heartbeat(ctx context.Context, id string, heartbeatAt time.Time) (alive bool, err error) {
+
+	result, err := workersScriptHeartbeat.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{
+			repo.buildKey(workersKeyData, id),
+			repo.buildKey(workersKeyIndexByHeartbeat),
+		},
+		id,
+		strconv.Itoa(int(models.WorkerStatusAlive)),
+		heartbeatAt.Format(time.RFC3339Nano),
+		timeToMs(heartbeatAt),
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "heartbeat script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// MarkDead atomically marks worker with given ID as dead, if it is alive and its last heartbeat is not after
+// given time. Returns IDs of the jobs the worker was holding, so their leases can be released.
+func (repo *WorkersRepository) MarkDead(
+	ctx context.Context,
+	id string,
+	heartbeatBefore time.Time,
+) (jobIds []string, marked bool, err error) {
+
+	result, err := workersScriptMarkDead.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{
+			repo.buildKey(workersKeyData, id),
+			repo.buildKey(workersKeyData, id, workersSuffixQueues),
+			repo.buildKey(workersKeyData, id, workersSuffixJobs),
+			repo.buildKey(workersKeyIndexById),
+			repo.buildKey(workersKeyIndexByHeartbeat),
+		},
+		id,
+		strconv.Itoa(int(models.WorkerStatusAlive)),
+		strconv.Itoa(int(models.WorkerStatusDead)),
+		timeToMs(heartbeatBefore),
+		int64(workersDeadRetention/time.Millisecond),
+	).Result()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.Wrap(err, "mark dead script failed")
+	}
+
+	for _, jobId := range result.([]interface{}) {
+		jobIds = append(jobIds, jobId.(string))
+	}
+
+	return jobIds, true, nil
+}
+
+// AddJob atomically adds job with given ID to the list of jobs the worker is holding, if the worker is alive.
+// Returns false if the worker does not exist or is dead.
+func (repo *WorkersRepository) AddJob(ctx context.Context, id string, jobId string) (added bool, err error) {
+
+	result, err := workersScriptAddJob.Run(
+		repo.redisClient.WithContext(ctx),
+		[]string{
+			repo.buildKey(workersKeyData, id),
+			repo.buildKey(workersKeyData, id, workersSuffixJobs),
+		},
+		jobId,
+		strconv.Itoa(int(models.WorkerStatusAlive)),
+	).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "add job script failed")
+	}
+
+	return result.(int64) == 1, nil
+}
+
+// RemoveJob removes job with given ID from the list of jobs the worker is holding.
+func (repo *WorkersRepository) RemoveJob(ctx context.Context, id string, jobId string) (err error) {
+
+	err = repo.redisClient.WithContext(ctx).SRem(repo.buildKey(workersKeyData, id, workersSuffixJobs), jobId).Err()
+
+	return errors.Wrap(err, "failed to remove job")
+}
+
+// buildKey is a helper function that builds a Redis key from key parts given.
+func (repo *WorkersRepository) buildKey(parts ...string) (key string) {
+	return strings.Join(parts, ":")
+}
+
+// workerMarshal is a helper function that marshals record into format Redis understands.
+func workerMarshal(record *models.Worker) (data map[string]interface{}, queuesData []interface{}) {
+
+	data = make(map[string]interface{})
+
+	data["id"] = record.Id
+	data["name"] = record.Name
+	data["hostname"] = record.Hostname
+	data["version"] = record.Version
+	data["concurrency"] = strconv.FormatUint(uint64(record.Concurrency), 10)
+	data["status"] = strconv.Itoa(int(record.Status))
+	data["created_at"] = record.CreatedAt.Format(time.RFC3339Nano)
+	data["heartbeat_at"] = record.HeartbeatAt.Format(time.RFC3339Nano)
+
+	for _, queue := range record.Queues {
+		queuesData = append(queuesData, queue)
+	}
+
+	return
+}
+
+// workerUnmarshal is a helper function that unmarshals record from Redis format.
+// Returns nil if data is empty (record does not exist).
+func workerUnmarshal(data map[string]string, queuesData, jobsData []string) (record *models.Worker) {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	concurrency, _ := strconv.ParseUint(data["concurrency"], 10, 32)
+	status, _ := strconv.Atoi(data["status"])
+	createdAt, _ := time.Parse(time.RFC3339Nano, data["created_at"])
+	heartbeatAt, _ := time.Parse(time.RFC3339Nano, data["heartbeat_at"])
+
+	return &models.Worker{
+		Id:          data["id"],
+		Name:        data["name"],
+		Hostname:    data["hostname"],
+		Version:     data["version"],
+		Queues:      queuesData,
+		Concurrency: uint32(concurrency),
+		Status:      models.WorkerStatus(status),
+		JobIds:      jobsData,
+		CreatedAt:   createdAt,
+		HeartbeatAt: heartbeatAt,
+	}
+}
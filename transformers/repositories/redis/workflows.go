@@ -0,0 +1,223 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gork-io/gork/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	workflowsKeyData      string = "workflows"
+	workflowsKeyIndexById string = "workflows:index:id"
+)
+
+// NewWorkflowsRepository creates a new instance of WorkflowsRepository.
+func NewWorkflowsRepository(redisClient *redis.Client) (repo *WorkflowsRepository) {
+	return &WorkflowsRepository{
+		redisClient: redisClient,
+		tasksRepo:   NewTasksRepository(redisClient),
+	}
+}
+
+// WorkflowsRepository implements a Redis-based workflows repository.
+// Tasks of the workflows are stored by the tasks repository, see TasksRepository for details.
+//
+// Redis schema:
+//   - HASH: `workflows:<workflow ID>`.
+//     Generic workflow information.
+//     Fields:
+//       - `id`;
+//       - `name`;
+//       - `nodes` (JSON-encoded list of the workflow tasks and their parents);
+//       - `created_at`.
+//   - SORTED SET: `workflows:index:id`.
+//     An index containing IDs of all known workflows and creation timestamp (ms) as a score.
+//   - SET: `tasks:<task ID>:children`.
+//     IDs of the workflow tasks that depend on the task.
+type WorkflowsRepository struct {
+	redisClient *redis.Client    // redis client instance
+	tasksRepo   *TasksRepository // tasks repository, used to save the tasks of the workflow
+}
+
+// Save persists given workflow instance along with its tasks to the repo in a single transaction.
+// Tasks without parents are put into the pending lists of their queues, the rest are waiting
+// until all their parents are finished.
+func (repo *WorkflowsRepository) Save(ctx context.Context, record *models.Workflow, tasks []*models.Task) (err error) {
+
+	data, err := workflowMarshal(record)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal record")
+	}
+
+	_, err = repo.redisClient.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) (err error) {
+		pipe.HMSet(repo.buildKey(workflowsKeyData, record.Id), data)
+		pipe.ZAdd(repo.buildKey(workflowsKeyIndexById), redis.Z{
+			Member: record.Id,
+			Score:  float64(timeToMs(record.CreatedAt)),
+		})
+		for _, task := range tasks {
+			repo.tasksRepo.save(pipe, task)
+		}
+		for _, node := range record.Nodes {
+			for _, parentId := range node.ParentIds {
+				pipe.SAdd(repo.buildKey(tasksKeyData, parentId, tasksSuffixChildren), node.TaskId)
+			}
+		}
+		return
+	})
+
+	return errors.Wrap(err, "transaction failed")
+}
+
+// GetById retrieves workflow with given ID from the repo.
+func (repo *WorkflowsRepository) GetById(ctx context.Context, id string) (record *models.Workflow, err error) {
+
+	records, err := repo.MGetById(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return records[0], nil
+}
+
+// MGetById retrieves workflows with given IDs from the repo.
+// Positions of the workflows that were not found are filled with nil.
+func (repo *WorkflowsRepository) MGetById(ctx context.Context, ids []string) (records []*models.Workflow, err error) {
+
+	var dataCmds []*redis.StringStringMapCmd
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		for _, id := range ids {
+			dataCmds = append(dataCmds, pipe.HGetAll(repo.buildKey(workflowsKeyData, id)))
+		}
+		return
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "pipeline failed")
+	}
+
+	for _, dataCmd := range dataCmds {
+		records = append(records, workflowUnmarshal(dataCmd.Val()))
+	}
+
+	return
+}
+
+// Find returns a subset of the workflows, based on collection params given.
+func (repo *WorkflowsRepository) Find(
+	ctx context.Context,
+	params *models.CollectionParams,
+) (records []*models.Workflow, info *models.CollectionInfo, err error) {
+
+	// Parse cursor
+	cursor, err := strconv.ParseUint(params.Cursor, 10, 64)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse cursor")
+	}
+
+	// Retrieve indexes
+	var (
+		idxCmd   *redis.ScanCmd
+		countCmd *redis.IntCmd
+	)
+	_, err = repo.redisClient.WithContext(ctx).Pipelined(func(pipe redis.Pipeliner) (err error) {
+		key := repo.buildKey(workflowsKeyIndexById)
+		idxCmd = pipe.ZScan(key, cursor, "*", int64(params.Limit))
+		countCmd = pipe.ZCard(key)
+		return
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to scan workflows index")
+	}
+
+	// ZSCAN returns member/score pairs, keep members only
+	keys, newCursor := idxCmd.Val()
+	var ids []string
+	for i := 0; i < len(keys); i += 2 {
+		ids = append(ids, keys[i])
+	}
+
+	// Retrieve records
+	records, err = repo.MGetById(ctx, ids)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to retrieve records")
+	}
+	info = &models.CollectionInfo{
+		Total:  uint64(countCmd.Val()),
+		Cursor: strconv.FormatUint(newCursor, 10),
+	}
+
+	return
+}
+
+// buildKey is a helper function that builds a Redis key from key parts given.
+func (repo *WorkflowsRepository) buildKey(parts ...string) (key string) {
+	return strings.Join(parts, ":")
+}
+
+// workflowNodeData represents a workflow node in the format it is stored in Redis.
+type workflowNodeData struct {
+	TaskId    string   `json:"task_id"`
+	Key       string   `json:"key"`
+	ParentIds []string `json:"parent_ids"`
+}
+
+// workflowMarshal is a helper function that marshals record into format Redis understands.
+func workflowMarshal(record *models.Workflow) (data map[string]interface{}, err error) {
+
+	nodesData := make([]workflowNodeData, 0, len(record.Nodes))
+	for _, node := range record.Nodes {
+		nodesData = append(nodesData, workflowNodeData{
+			TaskId:    node.TaskId,
+			Key:       node.Key,
+			ParentIds: node.ParentIds,
+		})
+	}
+	nodes, err := json.Marshal(nodesData)
+	if err != nil {
+		return nil, err
+	}
+
+	data = make(map[string]interface{})
+
+	data["id"] = record.Id
+	data["name"] = record.Name
+	data["nodes"] = string(nodes)
+	data["created_at"] = record.CreatedAt.Format(time.RFC3339Nano)
+
+	return
+}
+
+// workflowUnmarshal is a helper function that unmarshals record from Redis format.
+// Returns nil if data is empty (record does not exist).
+func workflowUnmarshal(data map[string]string) (record *models.Workflow) {
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	var nodesData []workflowNodeData
+	json.Unmarshal([]byte(data["nodes"]), &nodesData)
+	createdAt, _ := time.Parse(time.RFC3339Nano, data["created_at"])
+
+	record = &models.Workflow{
+		Id:        data["id"],
+		Name:      data["name"],
+		CreatedAt: createdAt,
+	}
+	for _, node := range nodesData {
+		record.Nodes = append(record.Nodes, &models.WorkflowNode{
+			TaskId:    node.TaskId,
+			Key:       node.Key,
+			ParentIds: node.ParentIds,
+		})
+	}
+
+	return
+}